@@ -31,15 +31,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
+	logAnalytics "github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2015-03-20/operationalinsights"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
 	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	monitorInsights "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-09-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/msi/mgmt/2015-08-31-preview/msi"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
@@ -52,6 +55,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	storagePolicies "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2017-05-01/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 
@@ -97,15 +101,22 @@ type ArmClient struct {
 	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
 	eventHubNamespacesClient    eventhub.NamespacesClient
 
-	workspacesClient operationalinsights.WorkspacesClient
-	solutionsClient  operationsmanagement.SolutionsClient
+	workspacesClient                operationalinsights.WorkspacesClient
+	solutionsClient                 operationsmanagement.SolutionsClient
+	linkedServicesClient            operationalinsights.LinkedServicesClient
+	logAnalyticsDataSourcesClient   operationalinsights.DataSourcesClient
+	logAnalyticsSavedSearchesClient logAnalytics.SavedSearchesClient
 
 	redisClient               redis.Client
 	redisFirewallClient       redis.FirewallRulesClient
 	redisPatchSchedulesClient redis.PatchSchedulesClient
 
 	// Application Insights
-	appInsightsClient appinsights.ComponentsClient
+	appInsightsClient                appinsights.ComponentsClient
+	appInsightsAnalyticsItemsClient  appinsights.AnalyticsItemsClient
+	appInsightsAPIKeyClient          appinsights.APIKeysClient
+	appInsightsBillingFeaturesClient appinsights.ComponentCurrentBillingFeaturesClient
+	appInsightsWebTestsClient        appinsights.WebTestsClient
 
 	// Authentication
 	roleAssignmentsClient   authorization.RoleAssignmentsClient
@@ -147,11 +158,27 @@ type ArmClient struct {
 	postgresqlFirewallRulesClient            postgresql.FirewallRulesClient
 	postgresqlServersClient                  postgresql.ServersClient
 	postgresqlVirtualNetworkRulesClient      postgresql.VirtualNetworkRulesClient
+	mssqlDatabasesClient                     MsSql.DatabasesClient
+	mssqlElasticPoolsClient                  MsSql.ElasticPoolsClient
+	sqlBackupLongTermRetentionPoliciesClient sql.BackupLongTermRetentionPoliciesClient
+	sqlBackupLongTermRetentionVaultsClient   sql.BackupLongTermRetentionVaultsClient
+	sqlCapabilitiesClient                    sql.CapabilitiesClient
 	sqlDatabasesClient                       sql.DatabasesClient
+	sqlDatabaseBlobAuditingPoliciesClient    sql.DatabaseBlobAuditingPoliciesClient
 	sqlDatabaseThreatDetectionPoliciesClient sql.DatabaseThreatDetectionPoliciesClient
+	sqlDataMaskingPoliciesClient             sql.DataMaskingPoliciesClient
+	sqlDataMaskingRulesClient                sql.DataMaskingRulesClient
 	sqlElasticPoolsClient                    sql.ElasticPoolsClient
+	sqlEncryptionProtectorsClient            sql.EncryptionProtectorsClient
+	sqlFailoverGroupsClient                  sql.FailoverGroupsClient
 	sqlFirewallRulesClient                   sql.FirewallRulesClient
+	sqlGeoBackupPoliciesClient               sql.GeoBackupPoliciesClient
+	sqlManagedInstancesClient                sql.ManagedInstancesClient
+	sqlReplicationLinksClient                sql.ReplicationLinksClient
+	sqlRestorableDroppedDatabasesClient      sql.RestorableDroppedDatabasesClient
+	sqlRestorePointsClient                   sql.RestorePointsClient
 	sqlServersClient                         sql.ServersClient
+	sqlServerKeysClient                      sql.ServerKeysClient
 	sqlServerAzureADAdministratorsClient     sql.ServerAzureADAdministratorsClient
 	sqlVirtualNetworkRulesClient             sql.VirtualNetworkRulesClient
 
@@ -176,8 +203,18 @@ type ArmClient struct {
 	managementGroupsSubscriptionClient managementgroups.SubscriptionsClient
 
 	// Monitor
-	actionGroupsClient      insights.ActionGroupsClient
-	monitorAlertRulesClient insights.AlertRulesClient
+	actionGroupsClient                      insights.ActionGroupsClient
+	monitorActivityLogAlertsClient          insights.ActivityLogAlertsClient
+	monitorAlertRulesClient                 insights.AlertRulesClient
+	monitorDiagnosticSettingsClient         insights.DiagnosticSettingsClient
+	monitorDiagnosticSettingsCategoryClient insights.DiagnosticSettingsCategoryClient
+	monitorLogProfilesClient                insights.LogProfilesClient
+	monitorMetricAlertsClient               insights.MetricAlertsClient
+	monitorMetricBaselineClient             insights.MetricBaselineClient
+	monitorMetricDefinitionsClient          insights.MetricDefinitionsClient
+	monitorMetricNamespacesClient           monitorInsights.MetricNamespacesClient
+	monitorMetricsClient                    insights.MetricsClient
+	monitorScheduledQueryRulesClient        insights.ScheduledQueryRulesClient
 
 	// MSI
 	userAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
@@ -242,8 +279,9 @@ type ArmClient struct {
 	serviceFabricClustersClient servicefabric.ClustersClient
 
 	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageServiceClient            storage.AccountsClient
+	storageManagementPoliciesClient storagePolicies.ManagementPoliciesClient
+	storageUsageClient              storage.UsageClient
 
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
@@ -251,8 +289,11 @@ type ArmClient struct {
 	trafficManagerEndpointsClient              trafficmanager.EndpointsClient
 
 	// Web
-	appServicePlansClient web.AppServicePlansClient
-	appServicesClient     web.AppsClient
+	appServiceCertificatesClient      web.CertificatesClient
+	appServiceCertificateOrdersClient web.AppServiceCertificateOrdersClient
+	appServiceEnvironmentsClient      web.AppServiceEnvironmentsClient
+	appServicePlansClient             web.AppServicePlansClient
+	appServicesClient                 web.AppsClient
 
 	// Policy
 	policyAssignmentsClient policy.AssignmentsClient
@@ -458,6 +499,22 @@ func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string,
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ai.Client, auth)
 	c.appInsightsClient = ai
+
+	analyticsItemsClient := appinsights.NewAnalyticsItemsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&analyticsItemsClient.Client, auth)
+	c.appInsightsAnalyticsItemsClient = analyticsItemsClient
+
+	apiKeyClient := appinsights.NewAPIKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&apiKeyClient.Client, auth)
+	c.appInsightsAPIKeyClient = apiKeyClient
+
+	billingFeaturesClient := appinsights.NewComponentCurrentBillingFeaturesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&billingFeaturesClient.Client, auth)
+	c.appInsightsBillingFeaturesClient = billingFeaturesClient
+
+	webTestsClient := appinsights.NewWebTestsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&webTestsClient.Client, auth)
+	c.appInsightsWebTestsClient = webTestsClient
 }
 
 func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -630,10 +687,34 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.postgresqlVirtualNetworkRulesClient = postgresqlVNRClient
 
 	// SQL Azure
+	sqlLTRPClient := sql.NewBackupLongTermRetentionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlLTRPClient.Client, auth)
+	c.sqlBackupLongTermRetentionPoliciesClient = sqlLTRPClient
+
+	sqlLTRVClient := sql.NewBackupLongTermRetentionVaultsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlLTRVClient.Client, auth)
+	c.sqlBackupLongTermRetentionVaultsClient = sqlLTRVClient
+
+	sqlCapabilitiesClient := sql.NewCapabilitiesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlCapabilitiesClient.Client, auth)
+	c.sqlCapabilitiesClient = sqlCapabilitiesClient
+
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlDBClient.Client, auth)
 	c.sqlDatabasesClient = sqlDBClient
 
+	sqlDBAPClient := sql.NewDatabaseBlobAuditingPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlDBAPClient.Client, auth)
+	c.sqlDatabaseBlobAuditingPoliciesClient = sqlDBAPClient
+
+	sqlDMPClient := sql.NewDataMaskingPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlDMPClient.Client, auth)
+	c.sqlDataMaskingPoliciesClient = sqlDMPClient
+
+	sqlDMRClient := sql.NewDataMaskingRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlDMRClient.Client, auth)
+	c.sqlDataMaskingRulesClient = sqlDMRClient
+
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
 	setUserAgent(&sqlDTDPClient.Client)
 	sqlDTDPClient.Authorizer = auth
@@ -649,10 +730,50 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.configureClient(&sqlEPClient.Client, auth)
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	mssqlDBClient := MsSql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mssqlDBClient.Client, auth)
+	c.mssqlDatabasesClient = mssqlDBClient
+
+	mssqlEPClient := MsSql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&mssqlEPClient.Client, auth)
+	c.mssqlElasticPoolsClient = mssqlEPClient
+
+	sqlEPRClient := sql.NewEncryptionProtectorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlEPRClient.Client, auth)
+	c.sqlEncryptionProtectorsClient = sqlEPRClient
+
+	sqlFGClient := sql.NewFailoverGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlFGClient.Client, auth)
+	c.sqlFailoverGroupsClient = sqlFGClient
+
+	sqlGBPClient := sql.NewGeoBackupPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlGBPClient.Client, auth)
+	c.sqlGeoBackupPoliciesClient = sqlGBPClient
+
+	sqlMIClient := sql.NewManagedInstancesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlMIClient.Client, auth)
+	c.sqlManagedInstancesClient = sqlMIClient
+
+	sqlRLClient := sql.NewReplicationLinksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlRLClient.Client, auth)
+	c.sqlReplicationLinksClient = sqlRLClient
+
+	sqlRDDClient := sql.NewRestorableDroppedDatabasesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlRDDClient.Client, auth)
+	c.sqlRestorableDroppedDatabasesClient = sqlRDDClient
+
+	sqlRPClient := sql.NewRestorePointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlRPClient.Client, auth)
+	c.sqlRestorePointsClient = sqlRPClient
+
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlSrvClient.Client, auth)
 	c.sqlServersClient = sqlSrvClient
 
+	sqlSKClient := sql.NewServerKeysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlSKClient.Client, auth)
+	c.sqlServerKeysClient = sqlSKClient
+
 	sqlADClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&sqlADClient.Client, auth)
 	c.sqlServerAzureADAdministratorsClient = sqlADClient
@@ -741,10 +862,50 @@ func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth
 	c.configureClient(&actionGroupsClient.Client, auth)
 	c.actionGroupsClient = actionGroupsClient
 
+	activityLogAlertsClient := insights.NewActivityLogAlertsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&activityLogAlertsClient.Client, auth)
+	c.monitorActivityLogAlertsClient = activityLogAlertsClient
+
 	arc := insights.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&arc.Client, auth)
 	c.monitorAlertRulesClient = arc
 
+	diagnosticSettingsClient := insights.NewDiagnosticSettingsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&diagnosticSettingsClient.Client, auth)
+	c.monitorDiagnosticSettingsClient = diagnosticSettingsClient
+
+	diagnosticSettingsCategoryClient := insights.NewDiagnosticSettingsCategoryClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&diagnosticSettingsCategoryClient.Client, auth)
+	c.monitorDiagnosticSettingsCategoryClient = diagnosticSettingsCategoryClient
+
+	logProfilesClient := insights.NewLogProfilesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&logProfilesClient.Client, auth)
+	c.monitorLogProfilesClient = logProfilesClient
+
+	metricAlertsClient := insights.NewMetricAlertsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&metricAlertsClient.Client, auth)
+	c.monitorMetricAlertsClient = metricAlertsClient
+
+	metricBaselineClient := insights.NewMetricBaselineClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&metricBaselineClient.Client, auth)
+	c.monitorMetricBaselineClient = metricBaselineClient
+
+	metricDefinitionsClient := insights.NewMetricDefinitionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&metricDefinitionsClient.Client, auth)
+	c.monitorMetricDefinitionsClient = metricDefinitionsClient
+
+	metricNamespacesClient := monitorInsights.NewMetricNamespacesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&metricNamespacesClient.Client, auth)
+	c.monitorMetricNamespacesClient = metricNamespacesClient
+
+	metricsClient := insights.NewMetricsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&metricsClient.Client, auth)
+	c.monitorMetricsClient = metricsClient
+
+	scheduledQueryRulesClient := insights.NewScheduledQueryRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scheduledQueryRulesClient.Client, auth)
+	c.monitorScheduledQueryRulesClient = scheduledQueryRulesClient
+
 	autoscaleSettingsClient := insights.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&autoscaleSettingsClient.Client, auth)
 	c.autoscaleSettingsClient = autoscaleSettingsClient
@@ -858,6 +1019,18 @@ func (c *ArmClient) registerOperationalInsightsClients(endpoint, subscriptionId
 	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(endpoint, subscriptionId, "Microsoft.OperationsManagement", "solutions", "testing")
 	c.configureClient(&solutionsClient.Client, auth)
 	c.solutionsClient = solutionsClient
+
+	linkedServicesClient := operationalinsights.NewLinkedServicesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&linkedServicesClient.Client, auth)
+	c.linkedServicesClient = linkedServicesClient
+
+	dataSourcesClient := operationalinsights.NewDataSourcesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&dataSourcesClient.Client, auth)
+	c.logAnalyticsDataSourcesClient = dataSourcesClient
+
+	savedSearchesClient := logAnalytics.NewSavedSearchesClientWithBaseURI(endpoint, subscriptionId, "")
+	c.configureClient(&savedSearchesClient.Client, auth)
+	c.logAnalyticsSavedSearchesClient = savedSearchesClient
 }
 
 func (c *ArmClient) registerRecoveryServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -961,6 +1134,10 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	c.configureClient(&accountsClient.Client, auth)
 	c.storageServiceClient = accountsClient
 
+	managementPoliciesClient := storagePolicies.NewManagementPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managementPoliciesClient.Client, auth)
+	c.storageManagementPoliciesClient = managementPoliciesClient
+
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.storageUsageClient = usageClient
@@ -981,6 +1158,18 @@ func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId strin
 }
 
 func (c *ArmClient) registerWebClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+	appServiceCertificatesClient := web.NewCertificatesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&appServiceCertificatesClient.Client, auth)
+	c.appServiceCertificatesClient = appServiceCertificatesClient
+
+	appServiceCertificateOrdersClient := web.NewAppServiceCertificateOrdersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&appServiceCertificateOrdersClient.Client, auth)
+	c.appServiceCertificateOrdersClient = appServiceCertificateOrdersClient
+
+	appServiceEnvironmentsClient := web.NewAppServiceEnvironmentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&appServiceEnvironmentsClient.Client, auth)
+	c.appServiceEnvironmentsClient = appServiceEnvironmentsClient
+
 	appServicePlansClient := web.NewAppServicePlansClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appServicePlansClient.Client, auth)
 	c.appServicePlansClient = appServicePlansClient
@@ -0,0 +1,108 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmAppServiceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmAppServiceEnvironmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"internal_load_balancing_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"front_end_scale_factor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"pricing_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: App Service Environment %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AppServiceEnvironment; props != nil {
+		d.Set("internal_load_balancing_mode", string(props.InternalLoadBalancingMode))
+		d.Set("dns_suffix", props.DNSSuffix)
+		d.Set("status", string(props.Status))
+
+		if v := props.FrontEndScaleFactor; v != nil {
+			d.Set("front_end_scale_factor", int(*v))
+		}
+
+		if v := props.MultiSize; v != nil {
+			for tier, size := range appServiceEnvironmentPricingTiers {
+				if strings.EqualFold(size, *v) {
+					d.Set("pricing_tier", tier)
+				}
+			}
+		}
+
+		if vnet := props.VirtualNetwork; vnet != nil && vnet.ID != nil && vnet.Subnet != nil {
+			d.Set("subnet_id", fmt.Sprintf("%s/subnets/%s", *vnet.ID, *vnet.Subnet))
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMMonitorActionGroup_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_monitor_action_group.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMMonitorActionGroup_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "short_name", "acctestag"),
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "email_receiver.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "email_receiver.0.email_address", "admin@contoso.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "webhook_receiver.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logic_app_receiver.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "sms_receiver.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMMonitorActionGroup_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  email_receiver {
+    name          = "sendtoadmin"
+    email_address = "admin@contoso.com"
+  }
+
+  webhook_receiver {
+    name        = "callmyapi"
+    service_uri = "http://example.com/alert"
+  }
+
+  logic_app_receiver {
+    name         = "logicappaction"
+    resource_id  = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-logicapp/providers/Microsoft.Logic/workflows/logicapp"
+    callback_url = "https://logicapptriggerurl/..."
+  }
+}
+
+data "azurerm_monitor_action_group" "test" {
+  name                = "${azurerm_monitor_action_group.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
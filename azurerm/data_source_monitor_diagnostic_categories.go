@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMonitorDiagnosticCategoriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsCategoryClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Get("resource_id").(string)
+
+	resp, err := client.List(ctx, strings.TrimPrefix(resourceId, "/"))
+	if err != nil {
+		return fmt.Errorf("Error retrieving Diagnostic Categories for Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId)
+
+	logs := make([]string, 0)
+	metrics := make([]string, 0)
+
+	if values := resp.Value; values != nil {
+		for _, v := range *values {
+			if v.Name == nil || v.DiagnosticSettingsCategory == nil {
+				continue
+			}
+
+			switch v.DiagnosticSettingsCategory.CategoryType {
+			case insights.Logs:
+				logs = append(logs, *v.Name)
+			case insights.Metrics:
+				metrics = append(metrics, *v.Name)
+			}
+		}
+	}
+
+	if err := d.Set("logs", logs); err != nil {
+		return fmt.Errorf("Error setting `logs`: %+v", err)
+	}
+
+	if err := d.Set("metrics", metrics); err != nil {
+		return fmt.Errorf("Error setting `metrics`: %+v", err)
+	}
+
+	return nil
+}
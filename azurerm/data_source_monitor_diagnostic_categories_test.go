@@ -0,0 +1,57 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceArmMonitorDiagnosticCategories_keyVault(t *testing.T) {
+	dataSourceName := "data.azurerm_monitor_diagnostic_categories.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceArmMonitorDiagnosticCategories_keyVault(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logs.0", "AuditEvent"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0", "AllMetrics"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceArmMonitorDiagnosticCategories_keyVault(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+
+data "azurerm_monitor_diagnostic_categories" "test" {
+  resource_id = "${azurerm_key_vault.test.id}"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func dataSourceArmMonitorMetricDefinitions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMonitorMetricDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"metric_namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"definitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"primary_aggregation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"supported_aggregation_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"dimension_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"dimensions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"availability": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"time_grain": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"retention": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMonitorMetricDefinitionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricDefinitionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Get("resource_id").(string)
+	metricNamespace := d.Get("metric_namespace").(string)
+
+	resp, err := client.List(ctx, strings.TrimPrefix(resourceId, "/"), metricNamespace)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Metric Definitions for Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId)
+
+	if err := d.Set("definitions", flattenMonitorMetricDefinitions(resp.Value)); err != nil {
+		return fmt.Errorf("Error setting `definitions`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMonitorMetricDefinitions(input *[]insights.MetricDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, definition := range *input {
+		result := make(map[string]interface{})
+
+		if definition.ID != nil {
+			result["id"] = *definition.ID
+		}
+
+		if name := definition.Name; name != nil {
+			if name.Value != nil {
+				result["name"] = *name.Value
+			}
+			if name.LocalizedValue != nil {
+				result["display_name"] = *name.LocalizedValue
+			}
+		}
+
+		if definition.Namespace != nil {
+			result["namespace"] = *definition.Namespace
+		}
+
+		result["unit"] = string(definition.Unit)
+		result["primary_aggregation_type"] = string(definition.PrimaryAggregationType)
+
+		aggregationTypes := make([]interface{}, 0)
+		if definition.SupportedAggregationTypes != nil {
+			for _, v := range *definition.SupportedAggregationTypes {
+				aggregationTypes = append(aggregationTypes, string(v))
+			}
+		}
+		result["supported_aggregation_types"] = aggregationTypes
+
+		if definition.IsDimensionRequired != nil {
+			result["dimension_required"] = *definition.IsDimensionRequired
+		}
+
+		dimensions := make([]interface{}, 0)
+		if definition.Dimensions != nil {
+			for _, v := range *definition.Dimensions {
+				if v.Value != nil {
+					dimensions = append(dimensions, *v.Value)
+				}
+			}
+		}
+		result["dimensions"] = dimensions
+
+		availabilities := make([]interface{}, 0)
+		if definition.MetricAvailabilities != nil {
+			for _, v := range *definition.MetricAvailabilities {
+				availability := make(map[string]interface{})
+				if v.TimeGrain != nil {
+					availability["time_grain"] = *v.TimeGrain
+				}
+				if v.Retention != nil {
+					availability["retention"] = *v.Retention
+				}
+				availabilities = append(availabilities, availability)
+			}
+		}
+		result["availability"] = availabilities
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,53 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceArmMonitorMetricDefinitions_storageAccount(t *testing.T) {
+	dataSourceName := "data.azurerm_monitor_metric_definitions.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceArmMonitorMetricDefinitions_storageAccount(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "definitions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "definitions.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "definitions.0.namespace", "Microsoft.Storage/storageAccounts"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceArmMonitorMetricDefinitions_storageAccount(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_monitor_metric_definitions" "test" {
+  resource_id      = "${azurerm_storage_account.test.id}"
+  metric_namespace = "Microsoft.Storage/storageAccounts"
+}
+`, rInt, location, rString)
+}
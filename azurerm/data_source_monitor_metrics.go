@@ -0,0 +1,517 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmMonitorMetrics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMonitorMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"metric_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"metric_namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// in the format `{startTime}/{endTime}` e.g. `2019-01-01T00:00:00Z/2019-01-08T00:00:00Z`
+			"timespan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+					"PT6H",
+					"PT12H",
+					"P1D",
+				}, false),
+			},
+
+			"aggregations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(insights.Average),
+						string(insights.Count),
+						string(insights.Maximum),
+						string(insights.Minimum),
+						string(insights.Total),
+					}, false),
+				},
+			},
+
+			"dimension": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+
+			"top": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"order_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"baseline_sensitivities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(insights.Low),
+						string(insights.Medium),
+						string(insights.High),
+					}, false),
+				},
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resource_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"timeseries": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeMap,
+										Computed: true,
+									},
+
+									"data": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"timestamp": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+
+												"average": {
+													Type:     schema.TypeFloat,
+													Computed: true,
+												},
+
+												"minimum": {
+													Type:     schema.TypeFloat,
+													Computed: true,
+												},
+
+												"maximum": {
+													Type:     schema.TypeFloat,
+													Computed: true,
+												},
+
+												"total": {
+													Type:     schema.TypeFloat,
+													Computed: true,
+												},
+
+												"count": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"baselines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"aggregation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"timestamps": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"threshold": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sensitivity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"low_thresholds": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeFloat},
+									},
+
+									"high_thresholds": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeFloat},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMonitorMetricsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceId := d.Get("resource_id").(string)
+	metricNames := expandMonitorMetricsStringList(d.Get("metric_names").([]interface{}))
+	metricNamespace := d.Get("metric_namespace").(string)
+	timespan := d.Get("timespan").(string)
+	aggregation := strings.Join(expandMonitorMetricsStringList(d.Get("aggregations").([]interface{})), ",")
+	filter := expandMonitorMetricsDimensionFilter(d.Get("dimension").([]interface{}))
+	orderBy := d.Get("order_by").(string)
+
+	var interval *string
+	if v := d.Get("interval").(string); v != "" {
+		interval = utils.String(v)
+	}
+
+	var top *int32
+	if v := d.Get("top").(int); v > 0 {
+		top = utils.Int32(int32(v))
+	}
+
+	resourceUri := strings.TrimPrefix(resourceId, "/")
+	resp, err := client.List(ctx, resourceUri, timespan, interval, strings.Join(metricNames, ","), aggregation, top, orderBy, filter, insights.Data, metricNamespace)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Metrics for Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId)
+
+	d.Set("timespan", resp.Timespan)
+	d.Set("interval", resp.Interval)
+	d.Set("namespace", resp.Namespace)
+	d.Set("resource_region", resp.Resourceregion)
+
+	if err := d.Set("metrics", flattenMonitorMetrics(resp.Value)); err != nil {
+		return fmt.Errorf("Error setting `metrics`: %+v", err)
+	}
+
+	baselines := make([]interface{}, 0)
+	sensitivities := expandMonitorMetricsStringList(d.Get("baseline_sensitivities").([]interface{}))
+	if len(sensitivities) > 0 {
+		baselineClient := meta.(*ArmClient).monitorMetricBaselineClient
+
+		for _, metricName := range metricNames {
+			baseline, err := baselineClient.Get(ctx, resourceUri, metricName, timespan, interval, aggregation, strings.Join(sensitivities, ","), insights.Data)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Baseline for Metric %q (Resource %q): %+v", metricName, resourceId, err)
+			}
+
+			baselines = append(baselines, flattenMonitorMetricsBaseline(metricName, baseline))
+		}
+	}
+
+	if err := d.Set("baselines", baselines); err != nil {
+		return fmt.Errorf("Error setting `baselines`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandMonitorMetricsStringList(input []interface{}) []string {
+	result := make([]string, 0)
+	for _, v := range input {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+// builds up an OData filter in the format `(A eq 'a1' or A eq 'a2') and B eq '*'` - where a dimension
+// has no values specified the metrics are split by that dimension instead
+func expandMonitorMetricsDimensionFilter(input []interface{}) string {
+	clauses := make([]string, 0)
+
+	for _, raw := range input {
+		dimension := raw.(map[string]interface{})
+		name := dimension["name"].(string)
+
+		values := expandMonitorMetricsStringList(dimension["values"].([]interface{}))
+		if len(values) == 0 {
+			values = []string{"*"}
+		}
+
+		conditions := make([]string, 0)
+		for _, value := range values {
+			conditions = append(conditions, fmt.Sprintf("%s eq '%s'", name, strings.Replace(value, "'", "''", -1)))
+		}
+
+		clause := strings.Join(conditions, " or ")
+		if len(conditions) > 1 {
+			clause = fmt.Sprintf("(%s)", clause)
+		}
+		clauses = append(clauses, clause)
+	}
+
+	return strings.Join(clauses, " and ")
+}
+
+func flattenMonitorMetrics(input *[]insights.Metric) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, metric := range *input {
+		result := make(map[string]interface{})
+
+		if metric.ID != nil {
+			result["id"] = *metric.ID
+		}
+
+		if name := metric.Name; name != nil {
+			if name.Value != nil {
+				result["name"] = *name.Value
+			}
+			if name.LocalizedValue != nil {
+				result["display_name"] = *name.LocalizedValue
+			}
+		}
+
+		result["unit"] = string(metric.Unit)
+
+		timeseries := make([]interface{}, 0)
+		if metric.Timeseries != nil {
+			for _, element := range *metric.Timeseries {
+				timeseries = append(timeseries, map[string]interface{}{
+					"dimensions": flattenMonitorMetricsMetadataValues(element.Metadatavalues),
+					"data":       flattenMonitorMetricsValues(element.Data),
+				})
+			}
+		}
+		result["timeseries"] = timeseries
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenMonitorMetricsMetadataValues(input *[]insights.MetadataValue) map[string]interface{} {
+	result := make(map[string]interface{})
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.Name == nil || v.Name.Value == nil {
+			continue
+		}
+
+		value := ""
+		if v.Value != nil {
+			value = *v.Value
+		}
+		result[*v.Name.Value] = value
+	}
+
+	return result
+}
+
+func flattenMonitorMetricsValues(input *[]insights.MetricValue) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		result := make(map[string]interface{})
+
+		if v.TimeStamp != nil {
+			result["timestamp"] = v.TimeStamp.String()
+		}
+		if v.Average != nil {
+			result["average"] = *v.Average
+		}
+		if v.Minimum != nil {
+			result["minimum"] = *v.Minimum
+		}
+		if v.Maximum != nil {
+			result["maximum"] = *v.Maximum
+		}
+		if v.Total != nil {
+			result["total"] = *v.Total
+		}
+		if v.Count != nil {
+			result["count"] = int(*v.Count)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenMonitorMetricsBaseline(metricName string, input insights.BaselineResponse) map[string]interface{} {
+	result := map[string]interface{}{
+		"metric_name": metricName,
+	}
+
+	props := input.BaselineProperties
+	if props == nil {
+		return result
+	}
+
+	if props.Aggregation != nil {
+		result["aggregation"] = *props.Aggregation
+	}
+
+	result["timestamps"] = flattenMonitorMetricsBaselineTimestamps(props.Timestamps)
+
+	thresholds := make([]interface{}, 0)
+	if props.Baseline != nil {
+		for _, v := range *props.Baseline {
+			lowThresholds := make([]interface{}, 0)
+			if v.LowThresholds != nil {
+				for _, t := range *v.LowThresholds {
+					lowThresholds = append(lowThresholds, t)
+				}
+			}
+
+			highThresholds := make([]interface{}, 0)
+			if v.HighThresholds != nil {
+				for _, t := range *v.HighThresholds {
+					highThresholds = append(highThresholds, t)
+				}
+			}
+
+			thresholds = append(thresholds, map[string]interface{}{
+				"sensitivity":     string(v.Sensitivity),
+				"low_thresholds":  lowThresholds,
+				"high_thresholds": highThresholds,
+			})
+		}
+	}
+	result["threshold"] = thresholds
+
+	return result
+}
+
+func flattenMonitorMetricsBaselineTimestamps(input *[]date.Time) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, v.String())
+	}
+
+	return results
+}
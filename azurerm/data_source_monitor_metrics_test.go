@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestExpandMonitorMetricsDimensionFilter(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected string
+	}{
+		{
+			Name:     "No Dimensions",
+			Input:    []interface{}{},
+			Expected: "",
+		},
+		{
+			Name: "Split By Dimension",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":   "ApiName",
+					"values": []interface{}{},
+				},
+			},
+			Expected: "ApiName eq '*'",
+		},
+		{
+			Name: "Single Value",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":   "ApiName",
+					"values": []interface{}{"GetBlob"},
+				},
+			},
+			Expected: "ApiName eq 'GetBlob'",
+		},
+		{
+			Name: "Multiple Dimensions and Values",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":   "ApiName",
+					"values": []interface{}{"GetBlob", "PutBlob"},
+				},
+				map[string]interface{}{
+					"name":   "GeoType",
+					"values": []interface{}{},
+				},
+			},
+			Expected: "(ApiName eq 'GetBlob' or ApiName eq 'PutBlob') and GeoType eq '*'",
+		},
+		{
+			Name: "Quoted Value",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":   "Instance",
+					"values": []interface{}{"it's"},
+				},
+			},
+			Expected: "Instance eq 'it''s'",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := expandMonitorMetricsDimensionFilter(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceArmMonitorMetrics_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_monitor_metrics.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceArmMonitorMetrics_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "timespan"),
+					resource.TestCheckResourceAttr(dataSourceName, "interval", "PT1H"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.name", "Transactions"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.unit", "Count"),
+					resource.TestCheckResourceAttr(dataSourceName, "baselines.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceArmMonitorMetrics_complete(t *testing.T) {
+	dataSourceName := "data.azurerm_monitor_metrics.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceArmMonitorMetrics_complete(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "timespan"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.0.name", "Transactions"),
+					resource.TestCheckResourceAttr(dataSourceName, "metrics.1.name", "Ingress"),
+					resource.TestCheckResourceAttr(dataSourceName, "baselines.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceArmMonitorMetrics_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccDataSourceArmMonitorMetrics_basic(rInt int, rString string, location string) string {
+	template := testAccDataSourceArmMonitorMetrics_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_metrics" "test" {
+  resource_id  = "${azurerm_storage_account.test.id}"
+  metric_names = ["Transactions"]
+  interval     = "PT1H"
+}
+`, template)
+}
+
+func testAccDataSourceArmMonitorMetrics_complete(rInt int, rString string, location string) string {
+	template := testAccDataSourceArmMonitorMetrics_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_monitor_metrics" "test" {
+  resource_id            = "${azurerm_storage_account.test.id}"
+  metric_names           = ["Transactions", "Ingress"]
+  metric_namespace       = "Microsoft.Storage/storageAccounts"
+  interval               = "PT1H"
+  aggregations           = ["Total", "Maximum"]
+  baseline_sensitivities = ["Low", "High"]
+
+  dimension {
+    name = "ApiName"
+  }
+}
+`, template)
+}
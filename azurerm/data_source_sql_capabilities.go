@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmSqlCapabilities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSqlCapabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azureRMSuppressLocationDiff,
+			},
+
+			"server_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "12.0",
+				ValidateFunc: validation.StringInSlice([]string{
+					"2.0",
+					"12.0",
+				}, false),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"editions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_objectives": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"performance_level": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"performance_level_unit": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"elastic_pool_editions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dtus": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSqlCapabilitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlCapabilitiesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	serverVersion := d.Get("server_version").(string)
+
+	resp, err := client.ListByLocation(ctx, location)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Capabilities for Location %q: %+v", location, err)
+	}
+
+	var capability *sql.ServerVersionCapability
+	if versions := resp.SupportedServerVersions; versions != nil {
+		for _, version := range *versions {
+			if version.Name != nil && *version.Name == serverVersion {
+				v := version
+				capability = &v
+				break
+			}
+		}
+	}
+	if capability == nil {
+		return fmt.Errorf("SQL Server Version %q isn't supported in Location %q", serverVersion, location)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("location", location)
+	d.Set("status", string(capability.Status))
+
+	if err := d.Set("editions", flattenArmSqlCapabilitiesEditions(capability.SupportedEditions)); err != nil {
+		return fmt.Errorf("Error setting `editions`: %+v", err)
+	}
+
+	if err := d.Set("elastic_pool_editions", flattenArmSqlCapabilitiesElasticPoolEditions(capability.SupportedElasticPoolEditions)); err != nil {
+		return fmt.Errorf("Error setting `elastic_pool_editions`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmSqlCapabilitiesEditions(input *[]sql.EditionCapability) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, edition := range *input {
+		result := map[string]interface{}{
+			"status": string(edition.Status),
+		}
+
+		if edition.Name != nil {
+			result["name"] = *edition.Name
+		}
+
+		serviceObjectives := make([]interface{}, 0)
+		if objectives := edition.SupportedServiceLevelObjectives; objectives != nil {
+			for _, objective := range *objectives {
+				serviceObjective := map[string]interface{}{
+					"status": string(objective.Status),
+				}
+
+				if objective.Name != nil {
+					serviceObjective["name"] = *objective.Name
+				}
+
+				if level := objective.PerformanceLevel; level != nil {
+					if level.Value != nil {
+						serviceObjective["performance_level"] = int(*level.Value)
+					}
+					serviceObjective["performance_level_unit"] = string(level.Unit)
+				}
+
+				serviceObjectives = append(serviceObjectives, serviceObjective)
+			}
+		}
+		result["service_objectives"] = serviceObjectives
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenArmSqlCapabilitiesElasticPoolEditions(input *[]sql.ElasticPoolEditionCapability) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, edition := range *input {
+		result := map[string]interface{}{
+			"status": string(edition.Status),
+		}
+
+		if edition.Name != nil {
+			result["name"] = *edition.Name
+		}
+
+		dtus := make([]interface{}, 0)
+		if limits := edition.SupportedElasticPoolDtus; limits != nil {
+			for _, limit := range *limits {
+				if limit.Limit != nil {
+					dtus = append(dtus, int(*limit.Limit))
+				}
+			}
+		}
+		result["dtus"] = dtus
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,38 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSqlCapabilities_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_sql_capabilities.test"
+	config := testAccDataSourceAzureRMSqlCapabilities_basic(testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "editions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "editions.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "editions.0.service_objectives.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "elastic_pool_editions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSqlCapabilities_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_sql_capabilities" "test" {
+  location = "%s"
+}
+`, location)
+}
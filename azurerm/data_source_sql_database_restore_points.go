@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmSqlDatabaseRestorePoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSqlDatabaseRestorePointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"earliest_restore_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"restore_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"earliest_restore_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSqlDatabaseRestorePointsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlRestorePointsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	resp, err := client.ListByDatabase(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error listing Restore Points for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	// the continuous restore point exposes the earliest point in time the database can be restored to
+	var earliestRestoreDate *date.Time
+	if resp.Value != nil {
+		for _, point := range *resp.Value {
+			props := point.RestorePointProperties
+			if props == nil || props.EarliestRestoreDate == nil {
+				continue
+			}
+
+			if earliestRestoreDate == nil || props.EarliestRestoreDate.Before(earliestRestoreDate.Time) {
+				earliestRestoreDate = props.EarliestRestoreDate
+			}
+		}
+	}
+
+	if earliestRestoreDate != nil {
+		d.Set("earliest_restore_date", earliestRestoreDate.String())
+	}
+
+	if err := d.Set("restore_points", flattenArmSqlDatabaseRestorePoints(resp.Value)); err != nil {
+		return fmt.Errorf("Error setting `restore_points`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmSqlDatabaseRestorePoints(input *[]sql.RestorePoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, point := range *input {
+		result := make(map[string]interface{})
+
+		if point.ID != nil {
+			result["id"] = *point.ID
+		}
+
+		if point.Name != nil {
+			result["name"] = *point.Name
+		}
+
+		if props := point.RestorePointProperties; props != nil {
+			result["type"] = string(props.RestorePointType)
+
+			if v := props.RestorePointCreationDate; v != nil {
+				result["creation_date"] = v.String()
+			}
+
+			if v := props.EarliestRestoreDate; v != nil {
+				result["earliest_restore_date"] = v.String()
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
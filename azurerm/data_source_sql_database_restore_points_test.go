@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSqlDatabaseRestorePoints_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_sql_database_restore_points.test"
+	ri := acctest.RandInt()
+	config := testAccDataSourceAzureRMSqlDatabaseRestorePoints_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "restore_points.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "restore_points.0.type", "CONTINUOUS"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "earliest_restore_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSqlDatabaseRestorePoints_basic(rInt int, location string) string {
+	config := testAccAzureRMSqlDatabase_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sql_database_restore_points" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.test.name}"
+}
+`, config)
+}
@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmSqlRestorableDroppedDatabases() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSqlRestorableDroppedDatabasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"edition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_level_objective": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"elastic_pool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_size_bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deletion_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"earliest_restore_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSqlRestorableDroppedDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlRestorableDroppedDatabasesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	resp, err := client.ListByServer(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error listing Restorable Dropped Databases for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	filteredDatabases := make([]sql.RestorableDroppedDatabase, 0)
+	if resp.Value != nil {
+		for _, database := range *resp.Value {
+			if databaseName != "" {
+				props := database.RestorableDroppedDatabaseProperties
+				if props == nil || props.DatabaseName == nil || *props.DatabaseName != databaseName {
+					continue
+				}
+			}
+
+			filteredDatabases = append(filteredDatabases, database)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("databases", flattenArmSqlRestorableDroppedDatabases(filteredDatabases)); err != nil {
+		return fmt.Errorf("Error setting `databases`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmSqlRestorableDroppedDatabases(input []sql.RestorableDroppedDatabase) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, database := range input {
+		result := make(map[string]interface{})
+
+		if database.ID != nil {
+			result["id"] = *database.ID
+		}
+
+		if props := database.RestorableDroppedDatabaseProperties; props != nil {
+			if v := props.DatabaseName; v != nil {
+				result["name"] = *v
+			}
+
+			if v := props.Edition; v != nil {
+				result["edition"] = *v
+			}
+
+			if v := props.ServiceLevelObjective; v != nil {
+				result["service_level_objective"] = *v
+			}
+
+			if v := props.ElasticPoolName; v != nil {
+				result["elastic_pool_name"] = *v
+			}
+
+			if v := props.MaxSizeBytes; v != nil {
+				result["max_size_bytes"] = *v
+			}
+
+			if v := props.CreationDate; v != nil {
+				result["creation_date"] = v.String()
+			}
+
+			if v := props.DeletionDate; v != nil {
+				result["deletion_date"] = v.String()
+			}
+
+			if v := props.EarliestRestoreDate; v != nil {
+				result["earliest_restore_date"] = v.String()
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
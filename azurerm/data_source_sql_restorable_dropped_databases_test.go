@@ -0,0 +1,73 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSqlRestorableDroppedDatabases_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_sql_restorable_dropped_databases.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	databaseConfig := testAccAzureRMSqlDatabase_basic(ri, location)
+	serverConfig := testAccDataSourceAzureRMSqlRestorableDroppedDatabases_template(ri, location)
+	dataSourceConfig := testAccDataSourceAzureRMSqlRestorableDroppedDatabases_basic(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+			},
+			{
+				// dropping the database makes it available to restore
+				Config: serverConfig,
+			},
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.0.name", fmt.Sprintf("acctestdb%d", ri)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "databases.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "databases.0.deletion_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMSqlRestorableDroppedDatabases_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+`, rInt, location, rInt)
+}
+
+func testAccDataSourceAzureRMSqlRestorableDroppedDatabases_basic(rInt int, location string) string {
+	template := testAccDataSourceAzureRMSqlRestorableDroppedDatabases_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_sql_restorable_dropped_databases" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "acctestdb%d"
+}
+`, template, rInt)
+}
@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageAccountBlobContainerSharedAccessSignature() *schema.Resource {
+	s := storageServiceSasSchema([]string{"read", "add", "create", "write", "delete", "list"}, true)
+	s["container_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validateArmStorageContainerName,
+	}
+	s["blob_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceArmStorageAccountBlobContainerSasRead,
+		Schema: s,
+	}
+}
+
+func dataSourceArmStorageAccountBlobContainerSasRead(d *schema.ResourceData, _ interface{}) error {
+	containerName := d.Get("container_name").(string)
+	blobName := d.Get("blob_name").(string)
+
+	options := storageServiceSasOptions{
+		service:        "blob",
+		resourcePath:   containerName,
+		signedResource: "c",
+		permissionNames: map[string]string{
+			"read":   "r",
+			"add":    "a",
+			"create": "c",
+			"write":  "w",
+			"delete": "d",
+			"list":   "l",
+		},
+		permissionsOrder: "racwdl",
+	}
+
+	// when a Blob is specified the SAS is scoped to that Blob rather than the Container
+	if blobName != "" {
+		if v := d.Get("permissions").([]interface{}); len(v) > 0 && v[0] != nil {
+			if v[0].(map[string]interface{})["list"].(bool) {
+				return fmt.Errorf("the `list` permission can only be granted when `blob_name` isn't specified")
+			}
+		}
+
+		options.resourcePath = fmt.Sprintf("%s/%s", containerName, blobName)
+		options.signedResource = "b"
+	}
+
+	return dataSourceArmStorageServiceSasRead(d, options)
+}
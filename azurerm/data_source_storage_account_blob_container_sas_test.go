@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceArmStorageAccountBlobContainerSas_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account_blob_container_sas.test"
+	rInt := acctest.RandInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageAccountBlobContainerSas_basic(rInt, rString, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "https_only", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "start", startDate),
+					resource.TestCheckResourceAttr(dataSourceName, "expiry", endDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceArmStorageAccountBlobContainerSas_accessPolicy(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account_blob_container_sas.test"
+	rInt := acctest.RandInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageAccountBlobContainerSas_accessPolicy(rInt, rString, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "access_policy_id", "policy1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+// TestDataSourceArmStorageAccountBlobContainerSas_signature ensures the SAS matches the one generated by the Storage SDK
+func TestDataSourceArmStorageAccountBlobContainerSas_signature(t *testing.T) {
+	accountName := "azurermtestsa0"
+	accountKey := "2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw=="
+	start := time.Date(2018, 3, 21, 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(2020, 3, 21, 0, 0, 0, 0, time.UTC)
+
+	client, err := mainStorage.NewClient(accountName, accountKey, "core.windows.net", sasSignedVersion, true)
+	if err != nil {
+		t.Fatalf("Error building Storage Client: %+v", err)
+	}
+	blobService := client.GetBlobService()
+	container := blobService.GetContainerReference("container1")
+
+	testCases := []struct {
+		canonicalizedResource string
+		signedResource        string
+		permissions           string
+		contentType           string
+		expected              func() (string, error)
+	}{
+		{
+			canonicalizedResource: "/blob/azurermtestsa0/container1",
+			signedResource:        "c",
+			permissions:           "rw",
+			expected: func() (string, error) {
+				return container.GetSASURI(mainStorage.ContainerSASOptions{
+					ContainerSASPermissions: mainStorage.ContainerSASPermissions{
+						BlobServiceSASPermissions: mainStorage.BlobServiceSASPermissions{
+							Read:  true,
+							Write: true,
+						},
+					},
+					SASOptions: mainStorage.SASOptions{
+						Start:    start,
+						Expiry:   expiry,
+						UseHTTPS: true,
+					},
+				})
+			},
+		},
+		{
+			canonicalizedResource: "/blob/azurermtestsa0/container1/blob1.txt",
+			signedResource:        "b",
+			permissions:           "r",
+			contentType:           "text/plain",
+			expected: func() (string, error) {
+				return container.GetBlobReference("blob1.txt").GetSASURI(mainStorage.BlobSASOptions{
+					BlobServiceSASPermissions: mainStorage.BlobServiceSASPermissions{
+						Read: true,
+					},
+					OverrideHeaders: mainStorage.OverrideHeaders{
+						ContentType: "text/plain",
+					},
+					SASOptions: mainStorage.SASOptions{
+						Start:    start,
+						Expiry:   expiry,
+						UseHTTPS: true,
+					},
+				})
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		headers := map[string]string{
+			"rsct": test.contentType,
+		}
+		sas, err := computeAzureStorageServiceSas(accountKey, test.canonicalizedResource, test.signedResource, test.permissions,
+			start.Format(time.RFC3339), expiry.Format(time.RFC3339), "", "", "https", sasSignedVersion, headers)
+		if err != nil {
+			t.Fatalf("Error computing SAS: %+v", err)
+		}
+
+		expectedUri, err := test.expected()
+		if err != nil {
+			t.Fatalf("Error computing SAS using the SDK: %+v", err)
+		}
+
+		actualSignature := testExtractSasSignature(t, sas)
+		expectedSignature := testExtractSasSignature(t, expectedUri)
+		if actualSignature != expectedSignature {
+			t.Fatalf("Expected the signature for %q to be %q but got %q", test.canonicalizedResource, expectedSignature, actualSignature)
+		}
+	}
+}
+
+func testExtractSasSignature(t *testing.T, input string) string {
+	uri, err := url.Parse(input)
+	if err != nil {
+		t.Fatalf("Error parsing %q: %+v", input, err)
+	}
+
+	return uri.Query().Get("sig")
+}
+
+func testAccDataSourceAzureRMStorageAccountBlobContainerSas_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestsa-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsads%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccDataSourceAzureRMStorageAccountBlobContainerSas_basic(rInt int, rString string, location string, startDate string, endDate string) string {
+	template := testAccDataSourceAzureRMStorageAccountBlobContainerSas_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  container_name    = "${azurerm_storage_container.test.name}"
+  https_only        = true
+  start             = "%s"
+  expiry            = "%s"
+  content_type      = "application/json"
+
+  permissions {
+    read   = true
+    add    = true
+    create = false
+    write  = false
+    delete = true
+    list   = true
+  }
+}
+`, template, startDate, endDate)
+}
+
+func testAccDataSourceAzureRMStorageAccountBlobContainerSas_accessPolicy(rInt int, rString string, location string) string {
+	template := testAccDataSourceAzureRMStorageAccountBlobContainerSas_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+
+  acl {
+    id = "policy1"
+
+    access_policy {
+      start       = "2019-07-02T09:38:21Z"
+      expiry      = "2029-07-02T09:38:21Z"
+      permissions = "rw"
+    }
+  }
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  container_name    = "${azurerm_storage_container.test.name}"
+  access_policy_id  = "${lookup(azurerm_storage_container.test.acl[0], "id")}"
+}
+`, template)
+}
@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageAccountQueueSharedAccessSignature() *schema.Resource {
+	s := storageServiceSasSchema([]string{"read", "add", "update", "process"}, false)
+	s["queue_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validateArmStorageQueueName,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceArmStorageAccountQueueSasRead,
+		Schema: s,
+	}
+}
+
+func dataSourceArmStorageAccountQueueSasRead(d *schema.ResourceData, _ interface{}) error {
+	return dataSourceArmStorageServiceSasRead(d, storageServiceSasOptions{
+		service:      "queue",
+		resourcePath: d.Get("queue_name").(string),
+		permissionNames: map[string]string{
+			"read":    "r",
+			"add":     "a",
+			"update":  "u",
+			"process": "p",
+		},
+		permissionsOrder: "raup",
+	})
+}
@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceArmStorageAccountQueueSas_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account_queue_sas.test"
+	rInt := acctest.RandInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageAccountQueueSas_basic(rInt, rString, location, startDate, endDate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "https_only", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "start", startDate),
+					resource.TestCheckResourceAttr(dataSourceName, "expiry", endDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDataSourceArmStorageAccountQueueSas_signature ensures the SAS matches the one generated by the Storage SDK
+func TestAccDataSourceArmStorageAccountQueueSas_signature(t *testing.T) {
+	accountName := "azurermtestsa0"
+	accountKey := "2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw=="
+	start := time.Date(2018, 3, 21, 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(2020, 3, 21, 0, 0, 0, 0, time.UTC)
+
+	client, err := mainStorage.NewClient(accountName, accountKey, "core.windows.net", sasSignedVersion, true)
+	if err != nil {
+		t.Fatalf("Error building Storage Client: %+v", err)
+	}
+	queueService := client.GetQueueService()
+	expectedUri, err := queueService.GetQueueReference("queue1").GetSASURI(mainStorage.QueueSASOptions{
+		QueueSASPermissions: mainStorage.QueueSASPermissions{
+			Read:    true,
+			Process: true,
+		},
+		SASOptions: mainStorage.SASOptions{
+			Start:    start,
+			Expiry:   expiry,
+			UseHTTPS: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error computing SAS using the SDK: %+v", err)
+	}
+
+	sas, err := computeAzureStorageServiceSas(accountKey, "/queue/azurermtestsa0/queue1", "", "rp",
+		start.Format(time.RFC3339), expiry.Format(time.RFC3339), "", "", "https", sasSignedVersion, map[string]string{})
+	if err != nil {
+		t.Fatalf("Error computing SAS: %+v", err)
+	}
+
+	actualSignature := testExtractSasSignature(t, sas)
+	expectedSignature := testExtractSasSignature(t, expectedUri)
+	if actualSignature != expectedSignature {
+		t.Fatalf("Expected the signature to be %q but got %q", expectedSignature, actualSignature)
+	}
+}
+
+func testAccDataSourceAzureRMStorageAccountQueueSas_basic(rInt int, rString string, location string, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestsa-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsads%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "sas-test"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+data "azurerm_storage_account_queue_sas" "test" {
+  connection_string = "${azurerm_storage_account.test.primary_connection_string}"
+  queue_name        = "${azurerm_storage_queue.test.name}"
+  https_only        = true
+  start             = "%s"
+  expiry            = "%s"
+
+  permissions {
+    read    = true
+    add     = false
+    update  = false
+    process = true
+  }
+}
+`, rInt, location, rString, startDate, endDate)
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStorageAccountShareSharedAccessSignature() *schema.Resource {
+	s := storageServiceSasSchema([]string{"read", "create", "write", "delete", "list"}, true)
+	s["share_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validateArmStorageShareName,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceArmStorageAccountShareSasRead,
+		Schema: s,
+	}
+}
+
+func dataSourceArmStorageAccountShareSasRead(d *schema.ResourceData, _ interface{}) error {
+	return dataSourceArmStorageServiceSasRead(d, storageServiceSasOptions{
+		service:        "file",
+		resourcePath:   d.Get("share_name").(string),
+		signedResource: "s",
+		permissionNames: map[string]string{
+			"read":   "r",
+			"create": "c",
+			"write":  "w",
+			"delete": "d",
+			"list":   "l",
+		},
+		permissionsOrder: "rcwdl",
+	})
+}
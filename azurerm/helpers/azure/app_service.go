@@ -1,14 +1,18 @@
 package azure
 
 import (
+	"fmt"
 	"log"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -206,6 +210,17 @@ func SchemaAppServiceSiteConfig() *schema.Schema {
 	}
 }
 
+// SchemaAppServiceSlotSiteConfig returns the `site_config` block for App Service Slots, which
+// additionally supports automatically swapping the Slot into another Slot once it's been deployed
+func SchemaAppServiceSlotSiteConfig() *schema.Schema {
+	s := SchemaAppServiceSiteConfig()
+	s.Elem.(*schema.Resource).Schema["auto_swap_slot_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return s
+}
+
 func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 	configs := input.([]interface{})
 	siteConfig := web.SiteConfig{}
@@ -330,6 +345,10 @@ func ExpandAppServiceSiteConfig(input interface{}) web.SiteConfig {
 		siteConfig.VnetName = utils.String(v.(string))
 	}
 
+	if v, ok := config["auto_swap_slot_name"]; ok {
+		siteConfig.AutoSwapSlotName = utils.String(v.(string))
+	}
+
 	return siteConfig
 }
 
@@ -435,9 +454,1107 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 		result["virtual_network_name"] = *input.VnetName
 	}
 
+	// this is only supported on Slots - so it's only set when it's configured
+	if input.AutoSwapSlotName != nil && *input.AutoSwapSlotName != "" {
+		result["auto_swap_slot_name"] = *input.AutoSwapSlotName
+	}
+
 	result["scm_type"] = string(input.ScmType)
 	result["ftps_state"] = string(input.FtpsState)
 	result["min_tls_version"] = string(input.MinTLSVersion)
 
 	return append(results, result)
 }
+
+func SchemaAppServiceAuthSettings() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"additional_login_params": {
+					Type:     schema.TypeMap,
+					Optional: true,
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"default_provider": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AzureActiveDirectory),
+						string(web.Facebook),
+						string(web.Google),
+						string(web.MicrosoftAccount),
+						string(web.Twitter),
+					}, false),
+				},
+
+				"issuer": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.URLIsHTTPOrHTTPS,
+				},
+
+				"runtime_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				"token_refresh_extension_hours": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  72,
+				},
+
+				"token_store_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"unauthenticated_client_action": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AllowAnonymous),
+						string(web.RedirectToLoginPage),
+					}, false),
+				},
+
+				"active_directory": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"client_secret": {
+								Type:      schema.TypeString,
+								Optional:  true,
+								Sensitive: true,
+							},
+							"allowed_audiences": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"facebook": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"app_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"google": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"client_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"microsoft": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"client_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"twitter": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"consumer_key": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"consumer_secret": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceAuthSettings(input []interface{}) web.SiteAuthSettingsProperties {
+	siteAuthSettings := web.SiteAuthSettingsProperties{}
+
+	if len(input) == 0 || input[0] == nil {
+		siteAuthSettings.Enabled = utils.Bool(false)
+		return siteAuthSettings
+	}
+
+	setting := input[0].(map[string]interface{})
+
+	if v, ok := setting["enabled"]; ok {
+		siteAuthSettings.Enabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := setting["additional_login_params"]; ok {
+		input := v.(map[string]interface{})
+
+		additionalLoginParams := make([]string, 0)
+		for k, v := range input {
+			additionalLoginParams = append(additionalLoginParams, fmt.Sprintf("%s=%s", k, v.(string)))
+		}
+
+		siteAuthSettings.AdditionalLoginParams = &additionalLoginParams
+	}
+
+	if v, ok := setting["allowed_external_redirect_urls"]; ok {
+		siteAuthSettings.AllowedExternalRedirectUrls = expandAppServiceStringList(v.([]interface{}))
+	}
+
+	if v, ok := setting["default_provider"]; ok {
+		siteAuthSettings.DefaultProvider = web.BuiltInAuthenticationProvider(v.(string))
+	}
+
+	if v, ok := setting["issuer"]; ok && v.(string) != "" {
+		siteAuthSettings.Issuer = utils.String(v.(string))
+	}
+
+	if v, ok := setting["runtime_version"]; ok && v.(string) != "" {
+		siteAuthSettings.RuntimeVersion = utils.String(v.(string))
+	}
+
+	if v, ok := setting["token_refresh_extension_hours"]; ok {
+		hours := v.(float64)
+		siteAuthSettings.TokenRefreshExtensionHours = &hours
+	}
+
+	if v, ok := setting["token_store_enabled"]; ok {
+		siteAuthSettings.TokenStoreEnabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := setting["unauthenticated_client_action"]; ok {
+		siteAuthSettings.UnauthenticatedClientAction = web.UnauthenticatedClientAction(v.(string))
+	}
+
+	if v, ok := setting["active_directory"]; ok {
+		activeDirectorySettings := v.([]interface{})
+
+		for _, setting := range activeDirectorySettings {
+			activeDirectorySetting := setting.(map[string]interface{})
+
+			siteAuthSettings.ClientID = utils.String(activeDirectorySetting["client_id"].(string))
+
+			if v := activeDirectorySetting["client_secret"].(string); v != "" {
+				siteAuthSettings.ClientSecret = utils.String(v)
+			}
+
+			siteAuthSettings.AllowedAudiences = expandAppServiceStringList(activeDirectorySetting["allowed_audiences"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["facebook"]; ok {
+		facebookSettings := v.([]interface{})
+
+		for _, setting := range facebookSettings {
+			facebookSetting := setting.(map[string]interface{})
+
+			siteAuthSettings.FacebookAppID = utils.String(facebookSetting["app_id"].(string))
+			siteAuthSettings.FacebookAppSecret = utils.String(facebookSetting["app_secret"].(string))
+			siteAuthSettings.FacebookOAuthScopes = expandAppServiceStringList(facebookSetting["oauth_scopes"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["google"]; ok {
+		googleSettings := v.([]interface{})
+
+		for _, setting := range googleSettings {
+			googleSetting := setting.(map[string]interface{})
+
+			siteAuthSettings.GoogleClientID = utils.String(googleSetting["client_id"].(string))
+			siteAuthSettings.GoogleClientSecret = utils.String(googleSetting["client_secret"].(string))
+			siteAuthSettings.GoogleOAuthScopes = expandAppServiceStringList(googleSetting["oauth_scopes"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["microsoft"]; ok {
+		microsoftSettings := v.([]interface{})
+
+		for _, setting := range microsoftSettings {
+			microsoftSetting := setting.(map[string]interface{})
+
+			siteAuthSettings.MicrosoftAccountClientID = utils.String(microsoftSetting["client_id"].(string))
+			siteAuthSettings.MicrosoftAccountClientSecret = utils.String(microsoftSetting["client_secret"].(string))
+			siteAuthSettings.MicrosoftAccountOAuthScopes = expandAppServiceStringList(microsoftSetting["oauth_scopes"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["twitter"]; ok {
+		twitterSettings := v.([]interface{})
+
+		for _, setting := range twitterSettings {
+			twitterSetting := setting.(map[string]interface{})
+
+			siteAuthSettings.TwitterConsumerKey = utils.String(twitterSetting["consumer_key"].(string))
+			siteAuthSettings.TwitterConsumerSecret = utils.String(twitterSetting["consumer_secret"].(string))
+		}
+	}
+
+	return siteAuthSettings
+}
+
+func FlattenAppServiceAuthSettings(input *web.SiteAuthSettingsProperties) []interface{} {
+	results := make([]interface{}, 0)
+	result := make(map[string]interface{}, 0)
+
+	if input == nil {
+		log.Printf("[DEBUG] SiteAuthSettingsProperties is nil")
+		return results
+	}
+
+	// this is how the Authentication Settings are returned when they've never been configured (or have been removed)
+	enabled := input.Enabled != nil && *input.Enabled
+	hasProvider := input.ClientID != nil || input.FacebookAppID != nil || input.GoogleClientID != nil || input.MicrosoftAccountClientID != nil || input.TwitterConsumerKey != nil
+	if !enabled && !hasProvider {
+		return results
+	}
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+
+	additionalLoginParams := make(map[string]interface{}, 0)
+	if input.AdditionalLoginParams != nil {
+		for _, param := range *input.AdditionalLoginParams {
+			parts := strings.SplitN(param, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			additionalLoginParams[parts[0]] = parts[1]
+		}
+	}
+	result["additional_login_params"] = additionalLoginParams
+
+	result["allowed_external_redirect_urls"] = flattenAppServiceStringList(input.AllowedExternalRedirectUrls)
+	result["default_provider"] = string(input.DefaultProvider)
+
+	if input.Issuer != nil {
+		result["issuer"] = *input.Issuer
+	}
+
+	if input.RuntimeVersion != nil {
+		result["runtime_version"] = *input.RuntimeVersion
+	}
+
+	if input.TokenRefreshExtensionHours != nil {
+		result["token_refresh_extension_hours"] = *input.TokenRefreshExtensionHours
+	}
+
+	if input.TokenStoreEnabled != nil {
+		result["token_store_enabled"] = *input.TokenStoreEnabled
+	}
+
+	result["unauthenticated_client_action"] = string(input.UnauthenticatedClientAction)
+
+	activeDirectorySettings := make([]interface{}, 0)
+	if input.ClientID != nil {
+		activeDirectorySetting := map[string]interface{}{
+			"client_id":         *input.ClientID,
+			"allowed_audiences": flattenAppServiceStringList(input.AllowedAudiences),
+		}
+		if input.ClientSecret != nil {
+			activeDirectorySetting["client_secret"] = *input.ClientSecret
+		}
+		activeDirectorySettings = append(activeDirectorySettings, activeDirectorySetting)
+	}
+	result["active_directory"] = activeDirectorySettings
+
+	facebookSettings := make([]interface{}, 0)
+	if input.FacebookAppID != nil {
+		facebookSetting := map[string]interface{}{
+			"app_id":       *input.FacebookAppID,
+			"oauth_scopes": flattenAppServiceStringList(input.FacebookOAuthScopes),
+		}
+		if input.FacebookAppSecret != nil {
+			facebookSetting["app_secret"] = *input.FacebookAppSecret
+		}
+		facebookSettings = append(facebookSettings, facebookSetting)
+	}
+	result["facebook"] = facebookSettings
+
+	googleSettings := make([]interface{}, 0)
+	if input.GoogleClientID != nil {
+		googleSetting := map[string]interface{}{
+			"client_id":    *input.GoogleClientID,
+			"oauth_scopes": flattenAppServiceStringList(input.GoogleOAuthScopes),
+		}
+		if input.GoogleClientSecret != nil {
+			googleSetting["client_secret"] = *input.GoogleClientSecret
+		}
+		googleSettings = append(googleSettings, googleSetting)
+	}
+	result["google"] = googleSettings
+
+	microsoftSettings := make([]interface{}, 0)
+	if input.MicrosoftAccountClientID != nil {
+		microsoftSetting := map[string]interface{}{
+			"client_id":    *input.MicrosoftAccountClientID,
+			"oauth_scopes": flattenAppServiceStringList(input.MicrosoftAccountOAuthScopes),
+		}
+		if input.MicrosoftAccountClientSecret != nil {
+			microsoftSetting["client_secret"] = *input.MicrosoftAccountClientSecret
+		}
+		microsoftSettings = append(microsoftSettings, microsoftSetting)
+	}
+	result["microsoft"] = microsoftSettings
+
+	twitterSettings := make([]interface{}, 0)
+	if input.TwitterConsumerKey != nil {
+		twitterSetting := map[string]interface{}{
+			"consumer_key": *input.TwitterConsumerKey,
+		}
+		if input.TwitterConsumerSecret != nil {
+			twitterSetting["consumer_secret"] = *input.TwitterConsumerSecret
+		}
+		twitterSettings = append(twitterSettings, twitterSetting)
+	}
+	result["twitter"] = twitterSettings
+
+	return append(results, result)
+}
+
+func expandAppServiceStringList(input []interface{}) *[]string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return &output
+}
+
+func flattenAppServiceStringList(input *[]string) []interface{} {
+	output := make([]interface{}, 0)
+	if input != nil {
+		for _, v := range *input {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func SchemaAppServiceLogsConfig() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"application_logs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file_system_level": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  string(web.Off),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(web.Error),
+									string(web.Information),
+									string(web.Off),
+									string(web.Verbose),
+									string(web.Warning),
+								}, false),
+							},
+							"azure_blob_storage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"level": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(web.Error),
+												string(web.Information),
+												string(web.Verbose),
+												string(web.Warning),
+											}, false),
+										},
+										"sas_url": {
+											Type:      schema.TypeString,
+											Required:  true,
+											Sensitive: true,
+										},
+										"retention_in_days": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"http_logs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file_system": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"retention_in_mb": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(25, 100),
+										},
+										"retention_in_days": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+									},
+								},
+							},
+							"azure_blob_storage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"sas_url": {
+											Type:      schema.TypeString,
+											Required:  true,
+											Sensitive: true,
+										},
+										"retention_in_days": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"detailed_error_messages_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"failed_request_tracing_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceLogs(input []interface{}) web.SiteLogsConfigProperties {
+	// the logs which aren't specified are explicitly disabled, since otherwise the existing configuration is retained
+	logs := web.SiteLogsConfigProperties{
+		ApplicationLogs: &web.ApplicationLogsConfig{
+			FileSystem: &web.FileSystemApplicationLogsConfig{
+				Level: web.Off,
+			},
+			AzureBlobStorage: &web.AzureBlobStorageApplicationLogsConfig{
+				Level: web.Off,
+			},
+		},
+		HTTPLogs: &web.HTTPLogsConfig{
+			FileSystem: &web.FileSystemHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+			AzureBlobStorage: &web.AzureBlobStorageHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+		},
+		DetailedErrorMessages: &web.EnabledConfig{
+			Enabled: utils.Bool(false),
+		},
+		FailedRequestsTracing: &web.EnabledConfig{
+			Enabled: utils.Bool(false),
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return logs
+	}
+
+	config := input[0].(map[string]interface{})
+
+	if v, ok := config["application_logs"]; ok {
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			applicationLogs := raw.(map[string]interface{})
+
+			logs.ApplicationLogs.FileSystem.Level = web.LogLevel(applicationLogs["file_system_level"].(string))
+
+			for _, blob := range applicationLogs["azure_blob_storage"].([]interface{}) {
+				blobStorage := blob.(map[string]interface{})
+				logs.ApplicationLogs.AzureBlobStorage = &web.AzureBlobStorageApplicationLogsConfig{
+					Level:           web.LogLevel(blobStorage["level"].(string)),
+					SasURL:          utils.String(blobStorage["sas_url"].(string)),
+					RetentionInDays: utils.Int32(int32(blobStorage["retention_in_days"].(int))),
+				}
+			}
+		}
+	}
+
+	if v, ok := config["http_logs"]; ok {
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			httpLogs := raw.(map[string]interface{})
+
+			for _, fs := range httpLogs["file_system"].([]interface{}) {
+				fileSystem := fs.(map[string]interface{})
+				logs.HTTPLogs.FileSystem = &web.FileSystemHTTPLogsConfig{
+					Enabled:         utils.Bool(true),
+					RetentionInMb:   utils.Int32(int32(fileSystem["retention_in_mb"].(int))),
+					RetentionInDays: utils.Int32(int32(fileSystem["retention_in_days"].(int))),
+				}
+			}
+
+			for _, blob := range httpLogs["azure_blob_storage"].([]interface{}) {
+				blobStorage := blob.(map[string]interface{})
+				logs.HTTPLogs.AzureBlobStorage = &web.AzureBlobStorageHTTPLogsConfig{
+					Enabled:         utils.Bool(true),
+					SasURL:          utils.String(blobStorage["sas_url"].(string)),
+					RetentionInDays: utils.Int32(int32(blobStorage["retention_in_days"].(int))),
+				}
+			}
+		}
+	}
+
+	if v, ok := config["detailed_error_messages_enabled"]; ok {
+		logs.DetailedErrorMessages.Enabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := config["failed_request_tracing_enabled"]; ok {
+		logs.FailedRequestsTracing.Enabled = utils.Bool(v.(bool))
+	}
+
+	return logs
+}
+
+func FlattenAppServiceLogs(input *web.SiteLogsConfigProperties) []interface{} {
+	results := make([]interface{}, 0)
+	result := make(map[string]interface{}, 0)
+
+	if input == nil {
+		log.Printf("[DEBUG] SiteLogsConfigProperties is nil")
+		return results
+	}
+
+	applicationLogs := make([]interface{}, 0)
+	if appLogs := input.ApplicationLogs; appLogs != nil {
+		applicationLog := map[string]interface{}{
+			"file_system_level": string(web.Off),
+		}
+
+		if fs := appLogs.FileSystem; fs != nil && fs.Level != "" {
+			applicationLog["file_system_level"] = string(fs.Level)
+		}
+
+		blobStorage := make([]interface{}, 0)
+		if blob := appLogs.AzureBlobStorage; blob != nil && blob.Level != web.Off && blob.SasURL != nil && *blob.SasURL != "" {
+			retentionInDays := 0
+			if blob.RetentionInDays != nil {
+				retentionInDays = int(*blob.RetentionInDays)
+			}
+
+			blobStorage = append(blobStorage, map[string]interface{}{
+				"level":             string(blob.Level),
+				"sas_url":           *blob.SasURL,
+				"retention_in_days": retentionInDays,
+			})
+		}
+		applicationLog["azure_blob_storage"] = blobStorage
+
+		// the Application Logs are omitted when they're disabled, to match them not being specified
+		if applicationLog["file_system_level"] != string(web.Off) || len(blobStorage) > 0 {
+			applicationLogs = append(applicationLogs, applicationLog)
+		}
+	}
+	result["application_logs"] = applicationLogs
+
+	httpLogs := make([]interface{}, 0)
+	if logs := input.HTTPLogs; logs != nil {
+		httpLog := make(map[string]interface{}, 0)
+
+		fileSystem := make([]interface{}, 0)
+		if fs := logs.FileSystem; fs != nil && fs.Enabled != nil && *fs.Enabled {
+			retentionInMb := 0
+			if fs.RetentionInMb != nil {
+				retentionInMb = int(*fs.RetentionInMb)
+			}
+			retentionInDays := 0
+			if fs.RetentionInDays != nil {
+				retentionInDays = int(*fs.RetentionInDays)
+			}
+
+			fileSystem = append(fileSystem, map[string]interface{}{
+				"retention_in_mb":   retentionInMb,
+				"retention_in_days": retentionInDays,
+			})
+		}
+		httpLog["file_system"] = fileSystem
+
+		blobStorage := make([]interface{}, 0)
+		if blob := logs.AzureBlobStorage; blob != nil && blob.Enabled != nil && *blob.Enabled {
+			sasUrl := ""
+			if blob.SasURL != nil {
+				sasUrl = *blob.SasURL
+			}
+			retentionInDays := 0
+			if blob.RetentionInDays != nil {
+				retentionInDays = int(*blob.RetentionInDays)
+			}
+
+			blobStorage = append(blobStorage, map[string]interface{}{
+				"sas_url":           sasUrl,
+				"retention_in_days": retentionInDays,
+			})
+		}
+		httpLog["azure_blob_storage"] = blobStorage
+
+		// as above, the HTTP Logs are omitted when they're disabled
+		if len(fileSystem) > 0 || len(blobStorage) > 0 {
+			httpLogs = append(httpLogs, httpLog)
+		}
+	}
+	result["http_logs"] = httpLogs
+
+	detailedErrorMessagesEnabled := false
+	if v := input.DetailedErrorMessages; v != nil && v.Enabled != nil {
+		detailedErrorMessagesEnabled = *v.Enabled
+	}
+	result["detailed_error_messages_enabled"] = detailedErrorMessagesEnabled
+
+	failedRequestTracingEnabled := false
+	if v := input.FailedRequestsTracing; v != nil && v.Enabled != nil {
+		failedRequestTracingEnabled = *v.Enabled
+	}
+	result["failed_request_tracing_enabled"] = failedRequestTracingEnabled
+
+	// when all of the logs are disabled this matches the `logs` block not being specified
+	if len(applicationLogs) == 0 && len(httpLogs) == 0 && !detailedErrorMessagesEnabled && !failedRequestTracingEnabled {
+		return results
+	}
+
+	return append(results, result)
+}
+
+func SchemaAppServiceBackup() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"storage_account_url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.URLIsHTTPOrHTTPS,
+				},
+
+				"schedule": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"frequency_interval": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 1000),
+							},
+
+							"frequency_unit": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(web.Day),
+									string(web.Hour),
+								}, false),
+							},
+
+							"keep_at_least_one_backup": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"retention_period_in_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      30,
+								ValidateFunc: validation.IntBetween(0, 9999999),
+							},
+
+							"start_time": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								DiffSuppressFunc: suppress.RFC3339Time,
+								ValidateFunc:     validate.RFC3339Time,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceBackup(input []interface{}) *web.BackupRequestProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	backup := input[0].(map[string]interface{})
+
+	properties := web.BackupRequestProperties{
+		BackupName:        utils.String(backup["name"].(string)),
+		Enabled:           utils.Bool(backup["enabled"].(bool)),
+		StorageAccountURL: utils.String(backup["storage_account_url"].(string)),
+	}
+
+	for _, raw := range backup["schedule"].([]interface{}) {
+		schedule := raw.(map[string]interface{})
+
+		properties.BackupSchedule = &web.BackupSchedule{
+			FrequencyInterval:     utils.Int32(int32(schedule["frequency_interval"].(int))),
+			FrequencyUnit:         web.FrequencyUnit(schedule["frequency_unit"].(string)),
+			KeepAtLeastOneBackup:  utils.Bool(schedule["keep_at_least_one_backup"].(bool)),
+			RetentionPeriodInDays: utils.Int32(int32(schedule["retention_period_in_days"].(int))),
+		}
+
+		if v := schedule["start_time"].(string); v != "" {
+			// this has been validated by the schema
+			startTime, _ := time.Parse(time.RFC3339, v)
+			properties.BackupSchedule.StartTime = &date.Time{Time: startTime}
+		}
+	}
+
+	return &properties
+}
+
+func FlattenAppServiceBackup(input *web.BackupRequestProperties) []interface{} {
+	results := make([]interface{}, 0)
+	result := make(map[string]interface{}, 0)
+
+	if input == nil {
+		log.Printf("[DEBUG] BackupRequestProperties is nil")
+		return results
+	}
+
+	if input.BackupName != nil {
+		result["name"] = *input.BackupName
+	}
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+
+	if input.StorageAccountURL != nil {
+		result["storage_account_url"] = *input.StorageAccountURL
+	}
+
+	schedules := make([]interface{}, 0)
+	if schedule := input.BackupSchedule; schedule != nil {
+		output := make(map[string]interface{}, 0)
+
+		if schedule.FrequencyInterval != nil {
+			output["frequency_interval"] = int(*schedule.FrequencyInterval)
+		}
+
+		output["frequency_unit"] = string(schedule.FrequencyUnit)
+
+		if schedule.KeepAtLeastOneBackup != nil {
+			output["keep_at_least_one_backup"] = *schedule.KeepAtLeastOneBackup
+		}
+
+		if schedule.RetentionPeriodInDays != nil {
+			output["retention_period_in_days"] = int(*schedule.RetentionPeriodInDays)
+		}
+
+		if schedule.StartTime != nil {
+			output["start_time"] = schedule.StartTime.Format(time.RFC3339)
+		}
+
+		schedules = append(schedules, output)
+	}
+	result["schedule"] = schedules
+
+	return append(results, result)
+}
+
+func SchemaAppServiceStorageAccounts() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AzureBlob),
+						string(web.AzureFiles),
+					}, false),
+				},
+
+				"account_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"share_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"access_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"mount_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceStorageAccounts(input []interface{}) map[string]*web.AzureStorageInfoValue {
+	output := make(map[string]*web.AzureStorageInfoValue, len(input))
+
+	for _, v := range input {
+		storageAccount := v.(map[string]interface{})
+
+		name := storageAccount["name"].(string)
+		output[name] = &web.AzureStorageInfoValue{
+			Type:        web.AzureStorageType(storageAccount["type"].(string)),
+			AccountName: utils.String(storageAccount["account_name"].(string)),
+			ShareName:   utils.String(storageAccount["share_name"].(string)),
+			AccessKey:   utils.String(storageAccount["access_key"].(string)),
+			MountPath:   utils.String(storageAccount["mount_path"].(string)),
+		}
+	}
+
+	return output
+}
+
+func FlattenAppServiceStorageAccounts(input map[string]*web.AzureStorageInfoValue) []interface{} {
+	results := make([]interface{}, 0)
+
+	for name, v := range input {
+		if v == nil {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"name": name,
+			"type": string(v.Type),
+		}
+
+		if v.AccountName != nil {
+			result["account_name"] = *v.AccountName
+		}
+
+		if v.ShareName != nil {
+			result["share_name"] = *v.ShareName
+		}
+
+		if v.AccessKey != nil {
+			result["access_key"] = *v.AccessKey
+		}
+
+		if v.MountPath != nil {
+			result["mount_path"] = *v.MountPath
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func SchemaAppServiceStickySettings() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"app_setting_names": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+
+				"connection_string_names": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceStickySettings(input []interface{}) web.SlotConfigNames {
+	appSettingNames := make([]string, 0)
+	connectionStringNames := make([]string, 0)
+
+	if len(input) > 0 && input[0] != nil {
+		setting := input[0].(map[string]interface{})
+		appSettingNames = *expandAppServiceStringList(setting["app_setting_names"].([]interface{}))
+		connectionStringNames = *expandAppServiceStringList(setting["connection_string_names"].([]interface{}))
+	}
+
+	return web.SlotConfigNames{
+		AppSettingNames:       &appSettingNames,
+		ConnectionStringNames: &connectionStringNames,
+	}
+}
+
+func FlattenAppServiceStickySettings(input *web.SlotConfigNames) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	appSettingNames := flattenAppServiceStringList(input.AppSettingNames)
+	connectionStringNames := flattenAppServiceStringList(input.ConnectionStringNames)
+	if len(appSettingNames) == 0 && len(connectionStringNames) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"app_setting_names":       appSettingNames,
+			"connection_string_names": connectionStringNames,
+		},
+	}
+}
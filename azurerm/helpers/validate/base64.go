@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+func Base64String(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid base64 encoded string: %+v", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,37 @@
+package validate
+
+import "testing"
+
+func TestBase64String(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "hello world",
+			Errors: 1,
+		},
+		{
+			Input:  "aGVsbG8gd29ybGQ",
+			Errors: 1,
+		},
+		{
+			Input:  "aGVsbG8gd29ybGQ=",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := Base64String(tc.Input, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected Base64String to have %d not %d errors for %q", tc.Errors, len(errors), tc.Input)
+			}
+		})
+	}
+}
@@ -16,6 +16,9 @@ func TestAccAzureRMAppServiceCustomHostnameBinding(t *testing.T) {
 			"basic":  testAccAzureRMAppServiceCustomHostnameBinding_basic,
 			"import": testAccAzureRMAppServiceCustomHostnameBinding_import,
 		},
+		"ssl": {
+			"sni": testAccAzureRMAppServiceCustomHostnameBinding_sni,
+		},
 	}
 
 	for group, m := range testCases {
@@ -80,8 +80,10 @@ func Provider() terraform.ResourceProvider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"azurerm_azuread_application":                   dataSourceArmAzureADApplication(),
 			"azurerm_azuread_service_principal":             dataSourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_application_insights":                  dataSourceArmApplicationInsights(),
 			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
 			"azurerm_app_service":                           dataSourceArmAppService(),
+			"azurerm_app_service_environment":               dataSourceArmAppServiceEnvironment(),
 			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
 			"azurerm_builtin_role_definition":               dataSourceArmBuiltInRoleDefinition(),
 			"azurerm_cdn_profile":                           dataSourceArmCdnProfile(),
@@ -100,6 +102,10 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_logic_app_workflow":                    dataSourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                          dataSourceArmManagedDisk(),
 			"azurerm_management_group":                      dataSourceArmManagementGroup(),
+			"azurerm_monitor_action_group":                  dataSourceArmMonitorActionGroup(),
+			"azurerm_monitor_diagnostic_categories":         dataSourceArmMonitorDiagnosticCategories(),
+			"azurerm_monitor_metric_definitions":            dataSourceArmMonitorMetricDefinitions(),
+			"azurerm_monitor_metrics":                       dataSourceArmMonitorMetrics(),
 			"azurerm_network_interface":                     dataSourceArmNetworkInterface(),
 			"azurerm_network_security_group":                dataSourceArmNetworkSecurityGroup(),
 			"azurerm_notification_hub":                      dataSourceNotificationHub(),
@@ -113,8 +119,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_route_table":                           dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":              dataSourceArmSchedulerJobCollection(),
 			"azurerm_snapshot":                              dataSourceArmSnapshot(),
+			"azurerm_sql_capabilities":                      dataSourceArmSqlCapabilities(),
+			"azurerm_sql_database_restore_points":           dataSourceArmSqlDatabaseRestorePoints(),
+			"azurerm_sql_restorable_dropped_databases":      dataSourceArmSqlRestorableDroppedDatabases(),
 			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
+			"azurerm_storage_account_blob_container_sas":    dataSourceArmStorageAccountBlobContainerSharedAccessSignature(),
+			"azurerm_storage_account_queue_sas":             dataSourceArmStorageAccountQueueSharedAccessSignature(),
 			"azurerm_storage_account_sas":                   dataSourceArmStorageAccountSharedAccessSignature(),
+			"azurerm_storage_account_share_sas":             dataSourceArmStorageAccountShareSharedAccessSignature(),
 			"azurerm_subnet":                                dataSourceArmSubnet(),
 			"azurerm_subscription":                          dataSourceArmSubscription(),
 			"azurerm_subscriptions":                         dataSourceArmSubscriptions(),
@@ -124,152 +136,177 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_azuread_application":                     resourceArmActiveDirectoryApplication(),
-			"azurerm_azuread_service_principal":               resourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_azuread_service_principal_password":      resourceArmActiveDirectoryServicePrincipalPassword(),
-			"azurerm_application_gateway":                     resourceArmApplicationGateway(),
-			"azurerm_application_insights":                    resourceArmApplicationInsights(),
-			"azurerm_application_security_group":              resourceArmApplicationSecurityGroup(),
-			"azurerm_app_service":                             resourceArmAppService(),
-			"azurerm_app_service_plan":                        resourceArmAppServicePlan(),
-			"azurerm_app_service_active_slot":                 resourceArmAppServiceActiveSlot(),
-			"azurerm_app_service_custom_hostname_binding":     resourceArmAppServiceCustomHostnameBinding(),
-			"azurerm_app_service_slot":                        resourceArmAppServiceSlot(),
-			"azurerm_automation_account":                      resourceArmAutomationAccount(),
-			"azurerm_automation_credential":                   resourceArmAutomationCredential(),
-			"azurerm_automation_runbook":                      resourceArmAutomationRunbook(),
-			"azurerm_automation_schedule":                     resourceArmAutomationSchedule(),
-			"azurerm_autoscale_setting":                       resourceArmAutoScaleSetting(),
-			"azurerm_availability_set":                        resourceArmAvailabilitySet(),
-			"azurerm_firewall":                                resourceArmFirewall(),
-			"azurerm_firewall_network_rule_collection":        resourceArmFirewallNetworkRuleCollection(),
-			"azurerm_cdn_endpoint":                            resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                             resourceArmCdnProfile(),
-			"azurerm_container_registry":                      resourceArmContainerRegistry(),
-			"azurerm_container_service":                       resourceArmContainerService(),
-			"azurerm_container_group":                         resourceArmContainerGroup(),
-			"azurerm_cosmosdb_account":                        resourceArmCosmosDBAccount(),
-			"azurerm_data_lake_analytics_account":             resourceArmDataLakeAnalyticsAccount(),
-			"azurerm_data_lake_analytics_firewall_rule":       resourceArmDataLakeAnalyticsFirewallRule(),
-			"azurerm_data_lake_store":                         resourceArmDataLakeStore(),
-			"azurerm_data_lake_store_file":                    resourceArmDataLakeStoreFile(),
-			"azurerm_data_lake_store_firewall_rule":           resourceArmDataLakeStoreFirewallRule(),
-			"azurerm_dns_a_record":                            resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":                         resourceArmDnsAAAARecord(),
-			"azurerm_dns_caa_record":                          resourceArmDnsCaaRecord(),
-			"azurerm_dns_cname_record":                        resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":                           resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":                           resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":                          resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":                          resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":                          resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                                resourceArmDnsZone(),
-			"azurerm_eventgrid_topic":                         resourceArmEventGridTopic(),
-			"azurerm_eventhub":                                resourceArmEventHub(),
-			"azurerm_eventhub_authorization_rule":             resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":                 resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace":                      resourceArmEventHubNamespace(),
-			"azurerm_eventhub_namespace_authorization_rule":   resourceArmEventHubNamespaceAuthorizationRule(),
-			"azurerm_express_route_circuit":                   resourceArmExpressRouteCircuit(),
-			"azurerm_express_route_circuit_authorization":     resourceArmExpressRouteCircuitAuthorization(),
-			"azurerm_express_route_circuit_peering":           resourceArmExpressRouteCircuitPeering(),
-			"azurerm_function_app":                            resourceArmFunctionApp(),
-			"azurerm_image":                                   resourceArmImage(),
-			"azurerm_iothub":                                  resourceArmIotHub(),
-			"azurerm_key_vault":                               resourceArmKeyVault(),
-			"azurerm_key_vault_access_policy":                 resourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_certificate":                   resourceArmKeyVaultCertificate(),
-			"azurerm_key_vault_key":                           resourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                        resourceArmKeyVaultSecret(),
-			"azurerm_kubernetes_cluster":                      resourceArmKubernetesCluster(),
-			"azurerm_lb":                                      resourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":                 resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_rule":                             resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_nat_pool":                             resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_probe":                                resourceArmLoadBalancerProbe(),
-			"azurerm_lb_rule":                                 resourceArmLoadBalancerRule(),
-			"azurerm_local_network_gateway":                   resourceArmLocalNetworkGateway(),
-			"azurerm_log_analytics_solution":                  resourceArmLogAnalyticsSolution(),
-			"azurerm_log_analytics_workspace":                 resourceArmLogAnalyticsWorkspace(),
-			"azurerm_logic_app_action_custom":                 resourceArmLogicAppActionCustom(),
-			"azurerm_logic_app_action_http":                   resourceArmLogicAppActionHTTP(),
-			"azurerm_logic_app_trigger_custom":                resourceArmLogicAppTriggerCustom(),
-			"azurerm_logic_app_trigger_http_request":          resourceArmLogicAppTriggerHttpRequest(),
-			"azurerm_logic_app_trigger_recurrence":            resourceArmLogicAppTriggerRecurrence(),
-			"azurerm_logic_app_workflow":                      resourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                            resourceArmManagedDisk(),
-			"azurerm_management_lock":                         resourceArmManagementLock(),
-			"azurerm_management_group":                        resourceArmManagementGroup(),
-			"azurerm_metric_alertrule":                        resourceArmMetricAlertRule(),
-			"azurerm_monitor_action_group":                    resourceArmMonitorActionGroup(),
-			"azurerm_mysql_configuration":                     resourceArmMySQLConfiguration(),
-			"azurerm_mysql_database":                          resourceArmMySqlDatabase(),
-			"azurerm_mysql_firewall_rule":                     resourceArmMySqlFirewallRule(),
-			"azurerm_mysql_server":                            resourceArmMySqlServer(),
-			"azurerm_mysql_virtual_network_rule":              resourceArmMySqlVirtualNetworkRule(),
-			"azurerm_network_interface":                       resourceArmNetworkInterface(),
-			"azurerm_network_security_group":                  resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":                   resourceArmNetworkSecurityRule(),
-			"azurerm_network_watcher":                         resourceArmNetworkWatcher(),
-			"azurerm_notification_hub":                        resourceArmNotificationHub(),
-			"azurerm_notification_hub_authorization_rule":     resourceArmNotificationHubAuthorizationRule(),
-			"azurerm_notification_hub_namespace":              resourceArmNotificationHubNamespace(),
-			"azurerm_packet_capture":                          resourceArmPacketCapture(),
-			"azurerm_policy_assignment":                       resourceArmPolicyAssignment(),
-			"azurerm_policy_definition":                       resourceArmPolicyDefinition(),
-			"azurerm_postgresql_configuration":                resourceArmPostgreSQLConfiguration(),
-			"azurerm_postgresql_database":                     resourceArmPostgreSQLDatabase(),
-			"azurerm_postgresql_firewall_rule":                resourceArmPostgreSQLFirewallRule(),
-			"azurerm_postgresql_server":                       resourceArmPostgreSQLServer(),
-			"azurerm_postgresql_virtual_network_rule":         resourceArmPostgreSQLVirtualNetworkRule(),
-			"azurerm_public_ip":                               resourceArmPublicIp(),
-			"azurerm_relay_namespace":                         resourceArmRelayNamespace(),
-			"azurerm_recovery_services_vault":                 resourceArmRecoveryServicesVault(),
-			"azurerm_redis_cache":                             resourceArmRedisCache(),
-			"azurerm_redis_firewall_rule":                     resourceArmRedisFirewallRule(),
-			"azurerm_resource_group":                          resourceArmResourceGroup(),
-			"azurerm_role_assignment":                         resourceArmRoleAssignment(),
-			"azurerm_role_definition":                         resourceArmRoleDefinition(),
-			"azurerm_route":                                   resourceArmRoute(),
-			"azurerm_route_table":                             resourceArmRouteTable(),
-			"azurerm_search_service":                          resourceArmSearchService(),
-			"azurerm_servicebus_namespace":                    resourceArmServiceBusNamespace(),
-			"azurerm_servicebus_namespace_authorization_rule": resourceArmServiceBusNamespaceAuthorizationRule(),
-			"azurerm_servicebus_queue":                        resourceArmServiceBusQueue(),
-			"azurerm_servicebus_queue_authorization_rule":     resourceArmServiceBusQueueAuthorizationRule(),
-			"azurerm_servicebus_subscription":                 resourceArmServiceBusSubscription(),
-			"azurerm_servicebus_subscription_rule":            resourceArmServiceBusSubscriptionRule(),
-			"azurerm_servicebus_topic":                        resourceArmServiceBusTopic(),
-			"azurerm_servicebus_topic_authorization_rule":     resourceArmServiceBusTopicAuthorizationRule(),
-			"azurerm_service_fabric_cluster":                  resourceArmServiceFabricCluster(),
-			"azurerm_snapshot":                                resourceArmSnapshot(),
-			"azurerm_scheduler_job":                           resourceArmSchedulerJob(),
-			"azurerm_scheduler_job_collection":                resourceArmSchedulerJobCollection(),
-			"azurerm_sql_database":                            resourceArmSqlDatabase(),
-			"azurerm_sql_elasticpool":                         resourceArmSqlElasticPool(),
-			"azurerm_sql_firewall_rule":                       resourceArmSqlFirewallRule(),
-			"azurerm_sql_active_directory_administrator":      resourceArmSqlAdministrator(),
-			"azurerm_sql_server":                              resourceArmSqlServer(),
-			"azurerm_sql_virtual_network_rule":                resourceArmSqlVirtualNetworkRule(),
-			"azurerm_storage_account":                         resourceArmStorageAccount(),
-			"azurerm_storage_blob":                            resourceArmStorageBlob(),
-			"azurerm_storage_container":                       resourceArmStorageContainer(),
-			"azurerm_storage_share":                           resourceArmStorageShare(),
-			"azurerm_storage_queue":                           resourceArmStorageQueue(),
-			"azurerm_storage_table":                           resourceArmStorageTable(),
-			"azurerm_subnet":                                  resourceArmSubnet(),
-			"azurerm_template_deployment":                     resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":                resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":                 resourceArmTrafficManagerProfile(),
-			"azurerm_user_assigned_identity":                  resourceArmUserAssignedIdentity(),
-			"azurerm_virtual_machine":                         resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_data_disk_attachment":    resourceArmVirtualMachineDataDiskAttachment(),
-			"azurerm_virtual_machine_extension":               resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine_scale_set":               resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":                         resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_gateway":                 resourceArmVirtualNetworkGateway(),
-			"azurerm_virtual_network_gateway_connection":      resourceArmVirtualNetworkGatewayConnection(),
-			"azurerm_virtual_network_peering":                 resourceArmVirtualNetworkPeering(),
+			"azurerm_azuread_application":                                  resourceArmActiveDirectoryApplication(),
+			"azurerm_azuread_service_principal":                            resourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_azuread_service_principal_password":                   resourceArmActiveDirectoryServicePrincipalPassword(),
+			"azurerm_application_gateway":                                  resourceArmApplicationGateway(),
+			"azurerm_application_insights":                                 resourceArmApplicationInsights(),
+			"azurerm_application_insights_analytics_item":                  resourceArmApplicationInsightsAnalyticsItem(),
+			"azurerm_application_insights_api_key":                         resourceArmApplicationInsightsAPIKey(),
+			"azurerm_application_insights_web_test":                        resourceArmApplicationInsightsWebTest(),
+			"azurerm_application_security_group":                           resourceArmApplicationSecurityGroup(),
+			"azurerm_app_service":                                          resourceArmAppService(),
+			"azurerm_app_service_plan":                                     resourceArmAppServicePlan(),
+			"azurerm_app_service_active_slot":                              resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_certificate":                              resourceArmAppServiceCertificate(),
+			"azurerm_app_service_certificate_order":                        resourceArmAppServiceCertificateOrder(),
+			"azurerm_app_service_environment":                              resourceArmAppServiceEnvironment(),
+			"azurerm_app_service_custom_hostname_binding":                  resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_slot":                                     resourceArmAppServiceSlot(),
+			"azurerm_automation_account":                                   resourceArmAutomationAccount(),
+			"azurerm_automation_credential":                                resourceArmAutomationCredential(),
+			"azurerm_automation_runbook":                                   resourceArmAutomationRunbook(),
+			"azurerm_automation_schedule":                                  resourceArmAutomationSchedule(),
+			"azurerm_autoscale_setting":                                    resourceArmAutoScaleSetting(),
+			"azurerm_availability_set":                                     resourceArmAvailabilitySet(),
+			"azurerm_firewall":                                             resourceArmFirewall(),
+			"azurerm_firewall_network_rule_collection":                     resourceArmFirewallNetworkRuleCollection(),
+			"azurerm_cdn_endpoint":                                         resourceArmCdnEndpoint(),
+			"azurerm_cdn_profile":                                          resourceArmCdnProfile(),
+			"azurerm_container_registry":                                   resourceArmContainerRegistry(),
+			"azurerm_container_service":                                    resourceArmContainerService(),
+			"azurerm_container_group":                                      resourceArmContainerGroup(),
+			"azurerm_cosmosdb_account":                                     resourceArmCosmosDBAccount(),
+			"azurerm_data_lake_analytics_account":                          resourceArmDataLakeAnalyticsAccount(),
+			"azurerm_data_lake_analytics_firewall_rule":                    resourceArmDataLakeAnalyticsFirewallRule(),
+			"azurerm_data_lake_store":                                      resourceArmDataLakeStore(),
+			"azurerm_data_lake_store_file":                                 resourceArmDataLakeStoreFile(),
+			"azurerm_data_lake_store_firewall_rule":                        resourceArmDataLakeStoreFirewallRule(),
+			"azurerm_dns_a_record":                                         resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                                      resourceArmDnsAAAARecord(),
+			"azurerm_dns_caa_record":                                       resourceArmDnsCaaRecord(),
+			"azurerm_dns_cname_record":                                     resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                                        resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                                        resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                                       resourceArmDnsPtrRecord(),
+			"azurerm_dns_srv_record":                                       resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                                       resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                             resourceArmDnsZone(),
+			"azurerm_eventgrid_topic":                                      resourceArmEventGridTopic(),
+			"azurerm_eventhub":                                             resourceArmEventHub(),
+			"azurerm_eventhub_authorization_rule":                          resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                              resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace":                                   resourceArmEventHubNamespace(),
+			"azurerm_eventhub_namespace_authorization_rule":                resourceArmEventHubNamespaceAuthorizationRule(),
+			"azurerm_express_route_circuit":                                resourceArmExpressRouteCircuit(),
+			"azurerm_express_route_circuit_authorization":                  resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_peering":                        resourceArmExpressRouteCircuitPeering(),
+			"azurerm_function_app":                                         resourceArmFunctionApp(),
+			"azurerm_image":                                                resourceArmImage(),
+			"azurerm_iothub":                                               resourceArmIotHub(),
+			"azurerm_key_vault":                                            resourceArmKeyVault(),
+			"azurerm_key_vault_access_policy":                              resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                                resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_key":                                        resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                                     resourceArmKeyVaultSecret(),
+			"azurerm_kubernetes_cluster":                                   resourceArmKubernetesCluster(),
+			"azurerm_lb":                                                   resourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                              resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_rule":                                          resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_nat_pool":                                          resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_probe":                                             resourceArmLoadBalancerProbe(),
+			"azurerm_lb_rule":                                              resourceArmLoadBalancerRule(),
+			"azurerm_local_network_gateway":                                resourceArmLocalNetworkGateway(),
+			"azurerm_log_analytics_datasource_windows_event":               resourceArmLogAnalyticsDataSourceWindowsEvent(),
+			"azurerm_log_analytics_datasource_windows_performance_counter": resourceArmLogAnalyticsDataSourceWindowsPerformanceCounter(),
+			"azurerm_log_analytics_intelligence_pack":                      resourceArmLogAnalyticsIntelligencePack(),
+			"azurerm_log_analytics_linked_service":                         resourceArmLogAnalyticsLinkedService(),
+			"azurerm_log_analytics_saved_search":                           resourceArmLogAnalyticsSavedSearch(),
+			"azurerm_log_analytics_solution":                               resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_workspace":                              resourceArmLogAnalyticsWorkspace(),
+			"azurerm_logic_app_action_custom":                              resourceArmLogicAppActionCustom(),
+			"azurerm_logic_app_action_http":                                resourceArmLogicAppActionHTTP(),
+			"azurerm_logic_app_trigger_custom":                             resourceArmLogicAppTriggerCustom(),
+			"azurerm_logic_app_trigger_http_request":                       resourceArmLogicAppTriggerHttpRequest(),
+			"azurerm_logic_app_trigger_recurrence":                         resourceArmLogicAppTriggerRecurrence(),
+			"azurerm_logic_app_workflow":                                   resourceArmLogicAppWorkflow(),
+			"azurerm_managed_disk":                                         resourceArmManagedDisk(),
+			"azurerm_management_lock":                                      resourceArmManagementLock(),
+			"azurerm_management_group":                                     resourceArmManagementGroup(),
+			"azurerm_metric_alertrule":                                     resourceArmMetricAlertRule(),
+			"azurerm_monitor_action_group":                                 resourceArmMonitorActionGroup(),
+			"azurerm_monitor_activity_log_alert":                           resourceArmMonitorActivityLogAlert(),
+			"azurerm_monitor_diagnostic_setting":                           resourceArmMonitorDiagnosticSetting(),
+			"azurerm_monitor_log_profile":                                  resourceArmMonitorLogProfile(),
+			"azurerm_monitor_metric_alert":                                 resourceArmMonitorMetricAlert(),
+			"azurerm_monitor_scheduled_query_rules_alert":                  resourceArmMonitorScheduledQueryRulesAlert(),
+			"azurerm_monitor_scheduled_query_rules_log":                    resourceArmMonitorScheduledQueryRulesLog(),
+			"azurerm_mssql_elasticpool":                                    resourceArmMsSqlElasticPool(),
+			"azurerm_mysql_configuration":                                  resourceArmMySQLConfiguration(),
+			"azurerm_mysql_database":                                       resourceArmMySqlDatabase(),
+			"azurerm_mysql_firewall_rule":                                  resourceArmMySqlFirewallRule(),
+			"azurerm_mysql_server":                                         resourceArmMySqlServer(),
+			"azurerm_mysql_virtual_network_rule":                           resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_network_interface":                                    resourceArmNetworkInterface(),
+			"azurerm_network_security_group":                               resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                                resourceArmNetworkSecurityRule(),
+			"azurerm_network_watcher":                                      resourceArmNetworkWatcher(),
+			"azurerm_notification_hub":                                     resourceArmNotificationHub(),
+			"azurerm_notification_hub_authorization_rule":                  resourceArmNotificationHubAuthorizationRule(),
+			"azurerm_notification_hub_namespace":                           resourceArmNotificationHubNamespace(),
+			"azurerm_packet_capture":                                       resourceArmPacketCapture(),
+			"azurerm_policy_assignment":                                    resourceArmPolicyAssignment(),
+			"azurerm_policy_definition":                                    resourceArmPolicyDefinition(),
+			"azurerm_postgresql_configuration":                             resourceArmPostgreSQLConfiguration(),
+			"azurerm_postgresql_database":                                  resourceArmPostgreSQLDatabase(),
+			"azurerm_postgresql_firewall_rule":                             resourceArmPostgreSQLFirewallRule(),
+			"azurerm_postgresql_server":                                    resourceArmPostgreSQLServer(),
+			"azurerm_postgresql_virtual_network_rule":                      resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_public_ip":                                            resourceArmPublicIp(),
+			"azurerm_relay_namespace":                                      resourceArmRelayNamespace(),
+			"azurerm_recovery_services_vault":                              resourceArmRecoveryServicesVault(),
+			"azurerm_redis_cache":                                          resourceArmRedisCache(),
+			"azurerm_redis_firewall_rule":                                  resourceArmRedisFirewallRule(),
+			"azurerm_resource_group":                                       resourceArmResourceGroup(),
+			"azurerm_role_assignment":                                      resourceArmRoleAssignment(),
+			"azurerm_role_definition":                                      resourceArmRoleDefinition(),
+			"azurerm_route":                                                resourceArmRoute(),
+			"azurerm_route_table":                                          resourceArmRouteTable(),
+			"azurerm_search_service":                                       resourceArmSearchService(),
+			"azurerm_servicebus_namespace":                                 resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_namespace_authorization_rule":              resourceArmServiceBusNamespaceAuthorizationRule(),
+			"azurerm_servicebus_queue":                                     resourceArmServiceBusQueue(),
+			"azurerm_servicebus_queue_authorization_rule":                  resourceArmServiceBusQueueAuthorizationRule(),
+			"azurerm_servicebus_subscription":                              resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_subscription_rule":                         resourceArmServiceBusSubscriptionRule(),
+			"azurerm_servicebus_topic":                                     resourceArmServiceBusTopic(),
+			"azurerm_servicebus_topic_authorization_rule":                  resourceArmServiceBusTopicAuthorizationRule(),
+			"azurerm_service_fabric_cluster":                               resourceArmServiceFabricCluster(),
+			"azurerm_snapshot":                                             resourceArmSnapshot(),
+			"azurerm_scheduler_job":                                        resourceArmSchedulerJob(),
+			"azurerm_scheduler_job_collection":                             resourceArmSchedulerJobCollection(),
+			"azurerm_sql_database":                                         resourceArmSqlDatabase(),
+			"azurerm_sql_database_data_masking_policy":                     resourceArmSqlDatabaseDataMaskingPolicy(),
+			"azurerm_sql_elasticpool":                                      resourceArmSqlElasticPool(),
+			"azurerm_sql_failover_group":                                   resourceArmSqlFailoverGroup(),
+			"azurerm_sql_failover_group_primary":                           resourceArmSqlFailoverGroupPrimary(),
+			"azurerm_sql_firewall_rule":                                    resourceArmSqlFirewallRule(),
+			"azurerm_sql_managed_instance":                                 resourceArmSqlManagedInstance(),
+			"azurerm_sql_active_directory_administrator":                   resourceArmSqlAdministrator(),
+			"azurerm_sql_server":                                           resourceArmSqlServer(),
+			"azurerm_sql_server_transparent_data_encryption":               resourceArmSqlServerTransparentDataEncryption(),
+			"azurerm_sql_virtual_network_rule":                             resourceArmSqlVirtualNetworkRule(),
+			"azurerm_storage_account":                                      resourceArmStorageAccount(),
+			"azurerm_storage_blob":                                         resourceArmStorageBlob(),
+			"azurerm_storage_container":                                    resourceArmStorageContainer(),
+			"azurerm_storage_management_policy":                            resourceArmStorageManagementPolicy(),
+			"azurerm_storage_share":                                        resourceArmStorageShare(),
+			"azurerm_storage_queue":                                        resourceArmStorageQueue(),
+			"azurerm_storage_table":                                        resourceArmStorageTable(),
+			"azurerm_subnet":                                               resourceArmSubnet(),
+			"azurerm_template_deployment":                                  resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                             resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                              resourceArmTrafficManagerProfile(),
+			"azurerm_user_assigned_identity":                               resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_machine":                                      resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_data_disk_attachment":                 resourceArmVirtualMachineDataDiskAttachment(),
+			"azurerm_virtual_machine_extension":                            resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine_monitoring_agent":                     resourceArmVirtualMachineMonitoringAgent(),
+			"azurerm_virtual_machine_scale_set":                            resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                                      resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_gateway":                              resourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_gateway_connection":                   resourceArmVirtualNetworkGatewayConnection(),
+			"azurerm_virtual_network_peering":                              resourceArmVirtualNetworkPeering(),
 		},
 	}
 
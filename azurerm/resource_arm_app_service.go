@@ -69,6 +69,16 @@ func resourceArmAppService() *schema.Resource {
 
 			"site_config": azure.SchemaAppServiceSiteConfig(),
 
+			"auth_settings": azure.SchemaAppServiceAuthSettings(),
+
+			"logs": azure.SchemaAppServiceLogsConfig(),
+
+			"backup": azure.SchemaAppServiceBackup(),
+
+			"storage_account": azure.SchemaAppServiceStorageAccounts(),
+
+			"sticky_settings": azure.SchemaAppServiceStickySettings(),
+
 			"client_affinity_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -306,6 +316,68 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("auth_settings") {
+		authSettingsProperties := azure.ExpandAppServiceAuthSettings(d.Get("auth_settings").([]interface{}))
+		authSettings := web.SiteAuthSettings{
+			SiteAuthSettingsProperties: &authSettingsProperties,
+		}
+
+		if _, err := client.UpdateAuthSettings(ctx, resGroup, name, authSettings); err != nil {
+			return fmt.Errorf("Error updating Authentication Settings for App Service %q: %+v", name, err)
+		}
+	}
+
+	if d.HasChange("logs") {
+		logs := azure.ExpandAppServiceLogs(d.Get("logs").([]interface{}))
+		logsConfig := web.SiteLogsConfig{
+			SiteLogsConfigProperties: &logs,
+		}
+
+		if _, err := client.UpdateDiagnosticLogsConfig(ctx, resGroup, name, logsConfig); err != nil {
+			return fmt.Errorf("Error updating Diagnostic Logs Configuration for App Service %q: %+v", name, err)
+		}
+	}
+
+	if d.HasChange("backup") {
+		if backup := azure.ExpandAppServiceBackup(d.Get("backup").([]interface{})); backup != nil {
+			request := web.BackupRequest{
+				BackupRequestProperties: backup,
+			}
+
+			if _, err := client.UpdateBackupConfiguration(ctx, resGroup, name, request); err != nil {
+				return fmt.Errorf("Error updating Backup Configuration for App Service %q: %+v", name, err)
+			}
+		} else {
+			resp, err := client.DeleteBackupConfiguration(ctx, resGroup, name)
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error removing Backup Configuration for App Service %q: %+v", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("storage_account") {
+		storageAccounts := azure.ExpandAppServiceStorageAccounts(d.Get("storage_account").(*schema.Set).List())
+		properties := web.AzureStoragePropertyDictionaryResource{
+			Properties: storageAccounts,
+		}
+
+		if _, err := client.UpdateAzureStorageAccounts(ctx, resGroup, name, properties); err != nil {
+			return fmt.Errorf("Error updating Storage Accounts for App Service %q: %+v", name, err)
+		}
+	}
+
+	if d.HasChange("sticky_settings") {
+		// the names of the App Settings & Connection Strings which remain with each Slot during a Swap
+		slotConfigNames := azure.ExpandAppServiceStickySettings(d.Get("sticky_settings").([]interface{}))
+		properties := web.SlotConfigNamesResource{
+			SlotConfigNames: &slotConfigNames,
+		}
+
+		if _, err := client.UpdateSlotConfigurationNames(ctx, resGroup, name, properties); err != nil {
+			return fmt.Errorf("Error updating Sticky Settings for App Service %q: %+v", name, err)
+		}
+	}
+
 	if d.HasChange("client_affinity_enabled") {
 
 		affinity := d.Get("client_affinity_enabled").(bool)
@@ -401,6 +473,31 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on AzureRM App Service Configuration %q: %+v", name, err)
 	}
 
+	logsResp, err := client.GetDiagnosticLogsConfiguration(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Diagnostic Logs Configuration %q: %+v", name, err)
+	}
+
+	backupResp, err := client.GetBackupConfiguration(ctx, resGroup, name)
+	if err != nil && !utils.ResponseWasNotFound(backupResp.Response) {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Backup Configuration %q: %+v", name, err)
+	}
+
+	storageAccountsResp, err := client.ListAzureStorageAccounts(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Storage Accounts %q: %+v", name, err)
+	}
+
+	authResp, err := client.GetAuthSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service AuthSettings %q: %+v", name, err)
+	}
+
+	slotConfigNamesResp, err := client.ListSlotConfigurationNames(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Configuration Names %q: %+v", name, err)
+	}
+
 	appSettingsResp, err := client.ListApplicationSettings(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error making Read request on AzureRM App Service AppSettings %q: %+v", name, err)
@@ -456,6 +553,31 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	logs := azure.FlattenAppServiceLogs(logsResp.SiteLogsConfigProperties)
+	if err := d.Set("logs", logs); err != nil {
+		return err
+	}
+
+	backup := azure.FlattenAppServiceBackup(backupResp.BackupRequestProperties)
+	if err := d.Set("backup", backup); err != nil {
+		return err
+	}
+
+	storageAccounts := azure.FlattenAppServiceStorageAccounts(storageAccountsResp.Properties)
+	if err := d.Set("storage_account", storageAccounts); err != nil {
+		return err
+	}
+
+	authSettings := azure.FlattenAppServiceAuthSettings(authResp.SiteAuthSettingsProperties)
+	if err := d.Set("auth_settings", authSettings); err != nil {
+		return err
+	}
+
+	stickySettings := azure.FlattenAppServiceStickySettings(slotConfigNamesResp.SlotConfigNames)
+	if err := d.Set("sticky_settings", stickySettings); err != nil {
+		return err
+	}
+
 	scm := flattenAppServiceSourceControl(scmResp.SiteSourceControlProperties)
 	if err := d.Set("source_control", scm); err != nil {
 		return err
@@ -1,11 +1,17 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -32,6 +38,30 @@ func resourceArmAppServiceActiveSlot() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+
+			"swap_with_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"preview_health_check_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+					if !strings.HasPrefix(v.(string), "/") {
+						es = append(es, fmt.Errorf("%q must start with a `/`", k))
+					}
+					return
+				},
+			},
+
+			"preview_health_check_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
 		},
 	}
 }
@@ -45,6 +75,11 @@ func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interfac
 	targetSlot := d.Get("app_service_slot_name").(string)
 	preserveVnet := true
 
+	// changing how the Swap is performed shouldn't trigger a Swap by itself
+	if !d.IsNewResource() && !d.HasChange("app_service_slot_name") {
+		return resourceArmAppServiceActiveSlotRead(d, meta)
+	}
+
 	resp, err := client.Get(ctx, resGroup, appServiceName)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -53,9 +88,9 @@ func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error making Read request on AzureRM App Service %q: %+v", appServiceName, err)
 	}
 
-	_, err = client.Get(ctx, resGroup, targetSlot)
+	slotResp, err := client.GetSlot(ctx, resGroup, appServiceName, targetSlot)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if utils.ResponseWasNotFound(slotResp.Response) {
 			return fmt.Errorf("[DEBUG] App Service Target Active Slot %q/%q (resource group %q) was not found.", appServiceName, targetSlot, resGroup)
 		}
 		return fmt.Errorf("Error making Read request on AzureRM App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
@@ -66,6 +101,40 @@ func resourceArmAppServiceActiveSlotCreate(d *schema.ResourceData, meta interfac
 		PreserveVnet: &preserveVnet,
 	}
 
+	if d.Get("swap_with_preview").(bool) {
+		// Phase 1: apply the Production configuration to the Slot, so that it can warm up with the final settings
+		previewSlotEntity := web.CsmSlotEntity{
+			TargetSlot:   utils.String("production"),
+			PreserveVnet: &preserveVnet,
+		}
+
+		log.Printf("[DEBUG] Applying the Production configuration to App Service Slot %q/%q (resource group %q)", appServiceName, targetSlot, resGroup)
+		if _, err := client.ApplySlotConfigurationSlot(ctx, resGroup, appServiceName, previewSlotEntity, targetSlot); err != nil {
+			return fmt.Errorf("Error applying the Production configuration to App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
+		}
+
+		if path := d.Get("preview_health_check_path").(string); path != "" {
+			if slotResp.SiteProperties == nil || slotResp.SiteProperties.DefaultHostName == nil {
+				return fmt.Errorf("Error retrieving the Default Hostname for App Service Slot %q/%q: `default_host_name` was nil", appServiceName, targetSlot)
+			}
+
+			url := fmt.Sprintf("https://%s%s", *slotResp.SiteProperties.DefaultHostName, path)
+			timeout := time.Duration(d.Get("preview_health_check_timeout_in_minutes").(int)) * time.Minute
+			if err := waitForAppServiceSlotHealthCheck(ctx, url, timeout); err != nil {
+				// the Slot isn't healthy, so cancel the Swap by restoring the Slot's original configuration
+				log.Printf("[DEBUG] Cancelling the Swap of App Service Slot %q/%q (resource group %q)", appServiceName, targetSlot, resGroup)
+				if _, resetErr := client.ResetSlotConfigurationSlot(ctx, resGroup, appServiceName, targetSlot); resetErr != nil {
+					return fmt.Errorf("Error resetting the configuration for App Service Slot %q/%q after the health check failed (%+v): %+v", appServiceName, targetSlot, err, resetErr)
+				}
+
+				return fmt.Errorf("Error waiting for App Service Slot %q/%q to become healthy - the Swap has been cancelled: %+v", appServiceName, targetSlot, err)
+			}
+		}
+
+		// Phase 2: complete the Swap, which happens below
+		log.Printf("[DEBUG] Completing the Swap of App Service Slot %q/%q (resource group %q)", appServiceName, targetSlot, resGroup)
+	}
+
 	future, err := client.SwapSlotWithProduction(ctx, resGroup, appServiceName, cmsSlotEntity)
 	if err != nil {
 		return fmt.Errorf("Error swapping App Service Slot %q/%q: %+v", appServiceName, targetSlot, err)
@@ -110,3 +179,47 @@ func resourceArmAppServiceActiveSlotDelete(d *schema.ResourceData, meta interfac
 	// There is nothing to delete so return nil
 	return nil
 }
+
+// waitForAppServiceSlotHealthCheck polls the specified URL until it returns a successful status code, or the timeout is reached
+func waitForAppServiceSlotHealthCheck(ctx context.Context, url string, timeout time.Duration) error {
+	const interval = 10 * time.Second
+
+	// requests are sent using the same User Agent & logging as the Resource Manager clients
+	client := autorest.NewClientWithUserAgent("")
+	setUserAgent(&client)
+	client.Sender = autorest.DecorateSender(&http.Client{Timeout: 30 * time.Second}, withRequestLogging())
+
+	attempts := int(timeout / interval)
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("Error building the Health Check request for %q: %+v", url, err)
+		}
+
+		resp, err := client.Do(req.WithContext(ctx))
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+				return nil
+			}
+			err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		lastErr = err
+		log.Printf("[DEBUG] Health Check %q failed (attempt %d of %d): %+v", url, attempt, attempts, err)
+
+		if attempt < attempts {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+	}
+
+	return fmt.Errorf("Health Check %q didn't succeed after %d attempts: %+v", url, attempts, lastErr)
+}
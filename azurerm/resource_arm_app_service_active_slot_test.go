@@ -57,6 +57,29 @@ func TestAccAzureRMAppServiceActiveSlot_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppServiceActiveSlot_swapWithPreview(t *testing.T) {
+	resourceName := "azurerm_app_service_active_slot.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppServiceActiveSlot_swapWithPreview(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// Destroy actually does nothing so we just return nil
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app_service_slot_name", fmt.Sprintf("acctestASSlot-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "swap_with_preview", "true"),
+					resource.TestCheckResourceAttr("azurerm_app_service.test", "app_settings.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureRMAppServiceActiveSlot_basic(rInt int, location string) string {
 	return fmt.Sprintf(`
   resource "azurerm_resource_group" "test" {
@@ -195,3 +218,59 @@ func testAccAzureRMAppServiceActiveSlot_updated(rInt int, location string) strin
   }
   `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMAppServiceActiveSlot_swapWithPreview(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  app_settings {
+    "environment" = "production"
+  }
+
+  sticky_settings {
+    app_setting_names = ["environment"]
+  }
+}
+
+resource "azurerm_app_service_slot" "test" {
+  name                = "acctestASSlot-%d"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  app_settings {
+    "environment" = "staging"
+  }
+}
+
+resource "azurerm_app_service_active_slot" "test" {
+  resource_group_name                     = "${azurerm_resource_group.test.name}"
+  app_service_name                        = "${azurerm_app_service.test.name}"
+  app_service_slot_name                   = "${azurerm_app_service_slot.test.name}"
+  swap_with_preview                       = true
+  preview_health_check_path               = "/"
+  preview_health_check_timeout_in_minutes = 5
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,353 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// App Service Certificate Orders are global resources - as such the location can't be specified
+const appServiceCertificateOrderLocation = "global"
+
+func resourceArmAppServiceCertificateOrder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCertificateOrderCreateUpdate,
+		Read:   resourceArmAppServiceCertificateOrderRead,
+		Update: resourceArmAppServiceCertificateOrderCreateUpdate,
+		Delete: resourceArmAppServiceCertificateOrderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"distinguished_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"csr"},
+			},
+
+			"csr": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"distinguished_name"},
+			},
+
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2048,
+				ValidateFunc: validateIntInSlice([]int{2048, 4096, 8192, 16384}),
+			},
+
+			"product_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Standard",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Standard",
+					"WildCard",
+				}, false),
+			},
+
+			"validity_in_years": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 3),
+			},
+
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"domain_verification_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_private_key_external": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"app_service_certificate_not_renewable_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"signed_certificate_thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_vault_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_vault_secret_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioning_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAppServiceCertificateOrderCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificateOrdersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for App Service Certificate Order creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	distinguishedName := d.Get("distinguished_name").(string)
+	csr := d.Get("csr").(string)
+	keySize := d.Get("key_size").(int)
+	validityInYears := d.Get("validity_in_years").(int)
+	autoRenew := d.Get("auto_renew").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if d.IsNewResource() && distinguishedName == "" && csr == "" {
+		return fmt.Errorf("Either `distinguished_name` or `csr` must be specified")
+	}
+
+	productType := web.StandardDomainValidatedSsl
+	if d.Get("product_type").(string) == "WildCard" {
+		productType = web.StandardDomainValidatedWildCardSsl
+	}
+
+	properties := web.AppServiceCertificateOrderProperties{
+		KeySize:         utils.Int32(int32(keySize)),
+		ProductType:     productType,
+		ValidityInYears: utils.Int32(int32(validityInYears)),
+		AutoRenew:       utils.Bool(autoRenew),
+	}
+
+	if distinguishedName != "" {
+		properties.DistinguishedName = utils.String(distinguishedName)
+	}
+
+	if csr != "" {
+		properties.Csr = utils.String(csr)
+	}
+
+	order := web.AppServiceCertificateOrder{
+		AppServiceCertificateOrderProperties: &properties,
+		Location:                             utils.String(appServiceCertificateOrderLocation),
+		Tags:                                 expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, order)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read App Service Certificate Order %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceCertificateOrderRead(d, meta)
+}
+
+func resourceArmAppServiceCertificateOrderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificateOrdersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["certificateOrders"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service Certificate Order %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.AppServiceCertificateOrderProperties; props != nil {
+		d.Set("distinguished_name", props.DistinguishedName)
+		d.Set("csr", props.Csr)
+		d.Set("domain_verification_token", props.DomainVerificationToken)
+		d.Set("status", string(props.Status))
+		d.Set("auto_renew", props.AutoRenew)
+		d.Set("is_private_key_external", props.IsPrivateKeyExternal)
+
+		if v := props.KeySize; v != nil {
+			d.Set("key_size", int(*v))
+		}
+
+		if v := props.ValidityInYears; v != nil {
+			d.Set("validity_in_years", int(*v))
+		}
+
+		productType := "Standard"
+		if props.ProductType == web.StandardDomainValidatedWildCardSsl {
+			productType = "WildCard"
+		}
+		d.Set("product_type", productType)
+
+		expirationTime := ""
+		if v := props.ExpirationTime; v != nil {
+			expirationTime = v.Format(time.RFC3339)
+		}
+		d.Set("expiration_time", expirationTime)
+
+		signedCertificateThumbprint := ""
+		if v := props.SignedCertificate; v != nil && v.Thumbprint != nil {
+			signedCertificateThumbprint = *v.Thumbprint
+		}
+		d.Set("signed_certificate_thumbprint", signedCertificateThumbprint)
+
+		notRenewableReasons := make([]interface{}, 0)
+		if v := props.AppServiceCertificateNotRenewableReasons; v != nil {
+			for _, reason := range *v {
+				notRenewableReasons = append(notRenewableReasons, reason)
+			}
+		}
+		if err := d.Set("app_service_certificate_not_renewable_reasons", notRenewableReasons); err != nil {
+			return fmt.Errorf("Error setting `app_service_certificate_not_renewable_reasons`: %+v", err)
+		}
+
+		if err := d.Set("certificates", flattenArmAppServiceCertificateOrderCertificates(props.Certificates)); err != nil {
+			return fmt.Errorf("Error setting `certificates`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceCertificateOrdersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["certificateOrders"]
+
+	log.Printf("[DEBUG] Deleting App Service Certificate Order %q (Resource Group %q)", name, resourceGroup)
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenArmAppServiceCertificateOrderCertificates(input map[string]*web.AppServiceCertificate) []interface{} {
+	results := make([]interface{}, 0)
+
+	// sort the names so that the ordering is consistent between refreshes
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		certificate := input[name]
+		if certificate == nil {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"certificate_name":   name,
+			"provisioning_state": string(certificate.ProvisioningState),
+		}
+
+		if v := certificate.KeyVaultID; v != nil {
+			result["key_vault_id"] = *v
+		}
+
+		if v := certificate.KeyVaultSecretName; v != nil {
+			result["key_vault_secret_name"] = *v
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAppServiceCertificateOrder_basic(t *testing.T) {
+	// NOTE: App Service Certificate Orders are purchased when they're created - as such this test
+	// is opt-in and requires a domain which the Certificate should be issued for
+	domainEnvVariable := "ARM_TEST_DOMAIN"
+	domainEnv := os.Getenv(domainEnvVariable)
+	if domainEnv == "" {
+		t.Skipf("Skipping as %q is not specified", domainEnvVariable)
+	}
+
+	resourceName := "azurerm_app_service_certificate_order.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateOrderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCertificateOrder_basic(ri, location, domainEnv, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateOrderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "distinguished_name", fmt.Sprintf("CN=%s", domainEnv)),
+					resource.TestCheckResourceAttr(resourceName, "product_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_verification_token"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceCertificateOrder_basic(ri, location, domainEnv, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCertificateOrderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceCertificateOrderDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServiceCertificateOrdersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_certificate_order" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service Certificate Order %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceCertificateOrderExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		orderName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for App Service Certificate Order: %s", orderName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appServiceCertificateOrdersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, orderName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: App Service Certificate Order %q (Resource Group %q) does not exist", orderName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServiceCertificateOrdersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceCertificateOrder_basic(rInt int, location string, domain string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_certificate_order" "test" {
+  name                = "acctestASCO-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  distinguished_name  = "CN=%s"
+  auto_renew          = %t
+}
+`, rInt, location, rInt, domain, autoRenew)
+}
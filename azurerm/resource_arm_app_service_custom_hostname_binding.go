@@ -6,13 +6,15 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceCustomHostnameBindingCreate,
+		Create: resourceArmAppServiceCustomHostnameBindingCreateUpdate,
 		Read:   resourceArmAppServiceCustomHostnameBindingRead,
+		Update: resourceArmAppServiceCustomHostnameBindingCreateUpdate,
 		Delete: resourceArmAppServiceCustomHostnameBindingDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -32,11 +34,32 @@ func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"ssl_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(web.SslStateDisabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.SslStateDisabled),
+					string(web.SslStateIPBasedEnabled),
+					string(web.SslStateSniEnabled),
+				}, false),
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"virtual_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceArmAppServiceCustomHostnameBindingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
 	ctx := meta.(*ArmClient).StopContext
 
@@ -45,12 +68,23 @@ func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, me
 	resourceGroup := d.Get("resource_group_name").(string)
 	appServiceName := d.Get("app_service_name").(string)
 	hostname := d.Get("hostname").(string)
+	sslState := d.Get("ssl_state").(string)
+	thumbprint := d.Get("thumbprint").(string)
+
+	if sslState != string(web.SslStateDisabled) && thumbprint == "" {
+		return fmt.Errorf("`thumbprint` must be specified when `ssl_state` is %q", sslState)
+	}
 
 	properties := web.HostNameBinding{
 		HostNameBindingProperties: &web.HostNameBindingProperties{
 			SiteName: utils.String(appServiceName),
+			SslState: web.SslState(sslState),
 		},
 	}
+
+	if thumbprint != "" {
+		properties.HostNameBindingProperties.Thumbprint = utils.String(thumbprint)
+	}
 	_, err := client.CreateOrUpdateHostNameBinding(ctx, resourceGroup, appServiceName, hostname, properties)
 	if err != nil {
 		return err
@@ -96,6 +130,16 @@ func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta
 	d.Set("app_service_name", appServiceName)
 	d.Set("resource_group_name", resourceGroup)
 
+	if props := resp.HostNameBindingProperties; props != nil {
+		sslState := string(web.SslStateDisabled)
+		if props.SslState != "" {
+			sslState = string(props.SslState)
+		}
+		d.Set("ssl_state", sslState)
+		d.Set("thumbprint", props.Thumbprint)
+		d.Set("virtual_ip", props.VirtualIP)
+	}
+
 	return nil
 }
 
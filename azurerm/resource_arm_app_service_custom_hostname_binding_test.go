@@ -45,6 +45,55 @@ func testAccAzureRMAppServiceCustomHostnameBinding_basic(t *testing.T) {
 	})
 }
 
+func testAccAzureRMAppServiceCustomHostnameBinding_sni(t *testing.T) {
+	appServiceEnvVariable := "ARM_TEST_APP_SERVICE"
+	appServiceEnv := os.Getenv(appServiceEnvVariable)
+	if appServiceEnv == "" {
+		t.Skipf("Skipping as %q is not specified", appServiceEnvVariable)
+	}
+
+	domainEnvVariable := "ARM_TEST_DOMAIN"
+	domainEnv := os.Getenv(domainEnvVariable)
+	if domainEnv == "" {
+		t.Skipf("Skipping as %q is not specified", domainEnvVariable)
+	}
+
+	// the path to a PFX file containing a Certificate which is valid for the domain above
+	certificateEnvVariable := "ARM_TEST_CERTIFICATE_PFX"
+	certificateEnv := os.Getenv(certificateEnvVariable)
+	if certificateEnv == "" {
+		t.Skipf("Skipping as %q is not specified", certificateEnvVariable)
+	}
+	certificatePassword := os.Getenv("ARM_TEST_CERTIFICATE_PASSWORD")
+
+	resourceName := "azurerm_app_service_custom_hostname_binding.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCustomHostnameBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCustomHostnameBinding_basicConfig(ri, location, appServiceEnv, domainEnv),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCustomHostnameBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "Disabled"),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceCustomHostnameBinding_sniConfig(ri, location, appServiceEnv, domainEnv, certificateEnv, certificatePassword),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCustomHostnameBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssl_state", "SniEnabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "thumbprint"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAppServiceCustomHostnameBindingDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).appServicesClient
 
@@ -132,3 +181,46 @@ resource "azurerm_app_service_custom_hostname_binding" "test" {
 }
 `, rInt, location, rInt, appServiceName, domain)
 }
+
+func testAccAzureRMAppServiceCustomHostnameBinding_sniConfig(rInt int, location string, appServiceName string, domain string, pfxPath string, pfxPassword string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_app_service_certificate" "test" {
+  name                = "acctestcert%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  pfx_blob            = "${base64encode(file("%s"))}"
+  password            = "%s"
+}
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname            = "%s"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  ssl_state           = "SniEnabled"
+  thumbprint          = "${azurerm_app_service_certificate.test.thumbprint}"
+}
+`, rInt, location, rInt, appServiceName, rInt, pfxPath, pfxPassword, domain)
+}
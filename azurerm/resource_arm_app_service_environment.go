@@ -0,0 +1,323 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Isolated Pricing Tiers exposed to users map to the size of the Front End instances within the ASE
+var appServiceEnvironmentPricingTiers = map[string]string{
+	"I1": "Standard_D1_V2",
+	"I2": "Standard_D2_V2",
+	"I3": "Standard_D3_V2",
+}
+
+func resourceArmAppServiceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceEnvironmentCreateUpdate,
+		Read:   resourceArmAppServiceEnvironmentRead,
+		Update: resourceArmAppServiceEnvironmentCreateUpdate,
+		Delete: resourceArmAppServiceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// provisioning an App Service Environment can take several hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+			Update: schema.DefaultTimeout(4 * time.Hour),
+			Delete: schema.DefaultTimeout(4 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAppServiceEnvironmentName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"internal_load_balancing_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(web.InternalLoadBalancingModeNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.InternalLoadBalancingModeNone),
+					string(web.InternalLoadBalancingModePublishing),
+					string(web.InternalLoadBalancingModeWeb),
+					"Web, Publishing",
+				}, false),
+			},
+
+			"front_end_scale_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15,
+				ValidateFunc: validation.IntBetween(5, 15),
+			},
+
+			"pricing_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "I1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"I1",
+					"I2",
+					"I3",
+				}, false),
+			},
+
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmAppServiceEnvironmentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for App Service Environment creation/update.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	internalLoadBalancingMode := d.Get("internal_load_balancing_mode").(string)
+	frontEndScaleFactor := d.Get("front_end_scale_factor").(int)
+	pricingTier := d.Get("pricing_tier").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	virtualNetwork, err := expandAppServiceEnvironmentVirtualNetwork(subnetId)
+	if err != nil {
+		return err
+	}
+
+	environment := web.AppServiceEnvironmentResource{
+		Kind:     utils.String("ASEV2"),
+		Location: utils.String(location),
+		Tags:     expandTags(tags),
+		AppServiceEnvironment: &web.AppServiceEnvironment{
+			Name:                      utils.String(name),
+			Location:                  utils.String(location),
+			VirtualNetwork:            virtualNetwork,
+			InternalLoadBalancingMode: web.InternalLoadBalancingMode(internalLoadBalancingMode),
+			FrontEndScaleFactor:       utils.Int32(int32(frontEndScaleFactor)),
+			MultiSize:                 utils.String(appServiceEnvironmentPricingTiers[pricingTier]),
+			// Worker Pools are only configurable for v1 App Service Environments, however the API requires this be set
+			WorkerPools: &[]web.WorkerPool{},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, environment); err != nil {
+		return fmt.Errorf("Error creating/updating App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// rather than waiting on the Future (which is bound by the client's polling duration) we poll the
+	// Provisioning State directly, so that the user-configurable timeout is honoured and progress is logged
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	log.Printf("[DEBUG] Waiting for App Service Environment %q (Resource Group %q) to finish provisioning", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(web.ProvisioningStateInProgress)},
+		Target:     []string{string(web.ProvisioningStateSucceeded)},
+		Refresh:    appServiceEnvironmentProvisioningStateRefreshFunc(meta, resourceGroup, name),
+		Timeout:    timeout,
+		Delay:      1 * time.Minute,
+		MinTimeout: 1 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for App Service Environment %q (Resource Group %q) to finish provisioning: %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read App Service Environment %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceEnvironmentRead(d, meta)
+}
+
+func resourceArmAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostingEnvironments"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] App Service Environment %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AppServiceEnvironment; props != nil {
+		d.Set("internal_load_balancing_mode", string(props.InternalLoadBalancingMode))
+		d.Set("dns_suffix", props.DNSSuffix)
+
+		if v := props.FrontEndScaleFactor; v != nil {
+			d.Set("front_end_scale_factor", int(*v))
+		}
+
+		if v := props.MultiSize; v != nil {
+			for tier, size := range appServiceEnvironmentPricingTiers {
+				if strings.EqualFold(size, *v) {
+					d.Set("pricing_tier", tier)
+				}
+			}
+		}
+
+		if vnet := props.VirtualNetwork; vnet != nil && vnet.ID != nil && vnet.Subnet != nil {
+			d.Set("subnet_id", fmt.Sprintf("%s/subnets/%s", *vnet.ID, *vnet.Subnet))
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmAppServiceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServiceEnvironmentsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["hostingEnvironments"]
+
+	log.Printf("[DEBUG] Deleting App Service Environment %q (Resource Group %q)", name, resourceGroup)
+
+	// `forceDelete` would also delete any App Service Plans & Apps within the ASE - which we don't want to do
+	future, err := client.Delete(ctx, resourceGroup, name, utils.Bool(false))
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error deleting App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for App Service Environment %q (Resource Group %q) to be deleted", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(web.ProvisioningStateDeleting), string(web.ProvisioningStateInProgress), string(web.ProvisioningStateSucceeded)},
+		Target:     []string{"NotFound"},
+		Refresh:    appServiceEnvironmentProvisioningStateRefreshFunc(meta, resourceGroup, name),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      1 * time.Minute,
+		MinTimeout: 1 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for App Service Environment %q (Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func appServiceEnvironmentProvisioningStateRefreshFunc(meta interface{}, resourceGroup string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).appServiceEnvironmentsClient
+		ctx := meta.(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		props := resp.AppServiceEnvironment
+		if props == nil {
+			return nil, "", fmt.Errorf("Error retrieving App Service Environment %q (Resource Group %q): `properties` was nil", name, resourceGroup)
+		}
+
+		state := string(props.ProvisioningState)
+		log.Printf("[DEBUG] App Service Environment %q (Resource Group %q) has Provisioning State %q and Status %q", name, resourceGroup, state, string(props.Status))
+		if props.ProvisioningState == web.ProvisioningStateFailed {
+			return resp, state, fmt.Errorf("App Service Environment %q (Resource Group %q) failed to provision", name, resourceGroup)
+		}
+
+		return resp, state, nil
+	}
+}
+
+func expandAppServiceEnvironmentVirtualNetwork(subnetId string) (*web.VirtualNetworkProfile, error) {
+	id, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualNetworkName := id.Path["virtualNetworks"]
+	subnetName := id.Path["subnets"]
+	if virtualNetworkName == "" || subnetName == "" {
+		return nil, fmt.Errorf("Error parsing `subnet_id` %q: expected a Subnet ID", subnetId)
+	}
+
+	virtualNetworkId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s", id.SubscriptionID, id.ResourceGroup, virtualNetworkName)
+	return &web.VirtualNetworkProfile{
+		ID:     utils.String(virtualNetworkId),
+		Subnet: utils.String(subnetName),
+	}, nil
+}
+
+func validateAppServiceEnvironmentName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z][0-9a-zA-Z-]{0,35}$`).Match([]byte(value)); !matched {
+		es = append(es, fmt.Errorf("%q may only contain alphanumeric characters and dashes up to 36 characters in length, and must start with an alphanumeric character", k))
+	}
+
+	return
+}
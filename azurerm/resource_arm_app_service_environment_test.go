@@ -0,0 +1,277 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAzureRMAppServiceEnvironmentName_validation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "ase1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "-hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "abcdefghijklmnopqrstuvwxyz0123456789",
+			ErrCount: 0,
+		},
+		{
+			Value:    "abcdefghijklmnopqrstuvwxyz0123456789a",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateAppServiceEnvironmentName(tc.Value, "azurerm_app_service_environment")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the App Service Environment Name to trigger a validation error for '%s'", tc.Value)
+		}
+	}
+}
+
+func TestAzureRMAppServiceEnvironment_expandVirtualNetwork(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	result, err := expandAppServiceEnvironmentVirtualNetwork(subnetId)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expectedId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	if *result.ID != expectedId {
+		t.Fatalf("Expected the Virtual Network ID to be %q but got %q", expectedId, *result.ID)
+	}
+
+	if *result.Subnet != "subnet1" {
+		t.Fatalf("Expected the Subnet to be %q but got %q", "subnet1", *result.Subnet)
+	}
+
+	if _, err := expandAppServiceEnvironmentVirtualNetwork("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"); err == nil {
+		t.Fatalf("Expected an error when parsing a Virtual Network ID but didn't get one")
+	}
+}
+
+func TestAccAzureRMAppServiceEnvironment_basic(t *testing.T) {
+	resourceName := "azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceEnvironment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internal_load_balancing_mode", "None"),
+					resource.TestCheckResourceAttr(resourceName, "front_end_scale_factor", "15"),
+					resource.TestCheckResourceAttr(resourceName, "pricing_tier", "I1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceEnvironment_update(t *testing.T) {
+	resourceName := "azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceEnvironment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMAppServiceEnvironment_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "front_end_scale_factor", "10"),
+					resource.TestCheckResourceAttr(resourceName, "pricing_tier", "I2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAppServiceEnvironment_internalLoadBalancer(t *testing.T) {
+	resourceName := "azurerm_app_service_environment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceEnvironment_internalLoadBalancer(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internal_load_balancing_mode", "Web, Publishing"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceEnvironmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServiceEnvironmentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_environment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service Environment %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceEnvironmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		environmentName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for App Service Environment: %s", environmentName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appServiceEnvironmentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, environmentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: App Service Environment %q (Resource Group %q) does not exist", environmentName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on appServiceEnvironmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceEnvironment_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMAppServiceEnvironment_basic(rInt int, location string) string {
+	template := testAccAzureRMAppServiceEnvironment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment" "test" {
+  name                = "acctest-ase-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  subnet_id           = "${azurerm_subnet.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppServiceEnvironment_complete(rInt int, location string) string {
+	template := testAccAzureRMAppServiceEnvironment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment" "test" {
+  name                   = "acctest-ase-%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  subnet_id              = "${azurerm_subnet.test.id}"
+  front_end_scale_factor = 10
+  pricing_tier           = "I2"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMAppServiceEnvironment_internalLoadBalancer(rInt int, location string) string {
+	template := testAccAzureRMAppServiceEnvironment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_environment" "test" {
+  name                         = "acctest-ase-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  subnet_id                    = "${azurerm_subnet.test.id}"
+  internal_load_balancing_mode = "Web, Publishing"
+}
+`, template, rInt)
+}
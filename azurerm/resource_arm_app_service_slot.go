@@ -72,7 +72,15 @@ func resourceArmAppServiceSlot() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"site_config": azure.SchemaAppServiceSiteConfig(),
+			"site_config": azure.SchemaAppServiceSlotSiteConfig(),
+
+			"auth_settings": azure.SchemaAppServiceAuthSettings(),
+
+			"logs": azure.SchemaAppServiceLogsConfig(),
+
+			"backup": azure.SchemaAppServiceBackup(),
+
+			"storage_account": azure.SchemaAppServiceStorageAccounts(),
 
 			"client_affinity_enabled": {
 				Type:     schema.TypeBool,
@@ -269,6 +277,56 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("auth_settings") {
+		authSettingsProperties := azure.ExpandAppServiceAuthSettings(d.Get("auth_settings").([]interface{}))
+		authSettings := web.SiteAuthSettings{
+			SiteAuthSettingsProperties: &authSettingsProperties,
+		}
+
+		if _, err := client.UpdateAuthSettingsSlot(ctx, resGroup, appServiceName, authSettings, slot); err != nil {
+			return fmt.Errorf("Error updating Authentication Settings for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
+	if d.HasChange("logs") {
+		logs := azure.ExpandAppServiceLogs(d.Get("logs").([]interface{}))
+		logsConfig := web.SiteLogsConfig{
+			SiteLogsConfigProperties: &logs,
+		}
+
+		if _, err := client.UpdateDiagnosticLogsConfigSlot(ctx, resGroup, appServiceName, logsConfig, slot); err != nil {
+			return fmt.Errorf("Error updating Diagnostic Logs Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
+	if d.HasChange("backup") {
+		if backup := azure.ExpandAppServiceBackup(d.Get("backup").([]interface{})); backup != nil {
+			request := web.BackupRequest{
+				BackupRequestProperties: backup,
+			}
+
+			if _, err := client.UpdateBackupConfigurationSlot(ctx, resGroup, appServiceName, request, slot); err != nil {
+				return fmt.Errorf("Error updating Backup Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			}
+		} else {
+			resp, err := client.DeleteBackupConfigurationSlot(ctx, resGroup, appServiceName, slot)
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error removing Backup Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			}
+		}
+	}
+
+	if d.HasChange("storage_account") {
+		storageAccounts := azure.ExpandAppServiceStorageAccounts(d.Get("storage_account").(*schema.Set).List())
+		properties := web.AzureStoragePropertyDictionaryResource{
+			Properties: storageAccounts,
+		}
+
+		if _, err := client.UpdateAzureStorageAccountsSlot(ctx, resGroup, appServiceName, properties, slot); err != nil {
+			return fmt.Errorf("Error updating Storage Accounts for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
 	if d.HasChange("client_affinity_enabled") {
 		affinity := d.Get("client_affinity_enabled").(bool)
 		sitePatchResource := web.SitePatchResource{
@@ -340,6 +398,26 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Configuration %q/%q: %+v", appServiceName, slot, err)
 	}
 
+	logsResp, err := client.GetDiagnosticLogsConfigurationSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Diagnostic Logs Configuration %q/%q: %+v", appServiceName, slot, err)
+	}
+
+	backupResp, err := client.GetBackupConfigurationSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil && !utils.ResponseWasNotFound(backupResp.Response) {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Backup Configuration %q/%q: %+v", appServiceName, slot, err)
+	}
+
+	storageAccountsResp, err := client.ListAzureStorageAccountsSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Storage Accounts %q/%q: %+v", appServiceName, slot, err)
+	}
+
+	authResp, err := client.GetAuthSettingsSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot AuthSettings %q/%q: %+v", appServiceName, slot, err)
+	}
+
 	appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, resGroup, appServiceName, slot)
 	if err != nil {
 		return fmt.Errorf("Error making Read request on AzureRM App Service Slot AppSettings %q/%q: %+v", appServiceName, slot, err)
@@ -377,6 +455,26 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	logs := azure.FlattenAppServiceLogs(logsResp.SiteLogsConfigProperties)
+	if err := d.Set("logs", logs); err != nil {
+		return err
+	}
+
+	backup := azure.FlattenAppServiceBackup(backupResp.BackupRequestProperties)
+	if err := d.Set("backup", backup); err != nil {
+		return err
+	}
+
+	storageAccounts := azure.FlattenAppServiceStorageAccounts(storageAccountsResp.Properties)
+	if err := d.Set("storage_account", storageAccounts); err != nil {
+		return err
+	}
+
+	authSettings := azure.FlattenAppServiceAuthSettings(authResp.SiteAuthSettingsProperties)
+	if err := d.Set("auth_settings", authSettings); err != nil {
+		return err
+	}
+
 	identity := flattenAzureRmAppServiceMachineIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
 		return err
@@ -48,6 +48,18 @@ func resourceArmApplicationInsights() *schema.Resource {
 				}, true),
 			},
 
+			"daily_data_cap_in_gb": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+			},
+
+			"daily_data_cap_notifications_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 
 			"app_id": {
@@ -66,6 +78,7 @@ func resourceArmApplicationInsights() *schema.Resource {
 
 func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsightsClient
+	billingClient := meta.(*ArmClient).appInsightsBillingFeaturesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	log.Printf("[INFO] preparing arguments for AzureRM Application Insights creation.")
@@ -109,11 +122,35 @@ func resourceArmApplicationInsightsCreateOrUpdate(d *schema.ResourceData, meta i
 
 	d.SetId(*read.ID)
 
+	capRaw, capOk := d.GetOkExists("daily_data_cap_in_gb")
+	notificationsRaw, notificationsOk := d.GetOkExists("daily_data_cap_notifications_disabled")
+	if capOk || notificationsOk {
+		billing, err := billingClient.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Billing Features for Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if billing.DataVolumeCap == nil {
+			billing.DataVolumeCap = &insights.ApplicationInsightsComponentDataVolumeCap{}
+		}
+		if capOk {
+			billing.DataVolumeCap.Cap = utils.Float(capRaw.(float64))
+		}
+		if notificationsOk {
+			billing.DataVolumeCap.StopSendNotificationWhenHitCap = utils.Bool(notificationsRaw.(bool))
+		}
+
+		if _, err := billingClient.Update(ctx, resGroup, name, billing); err != nil {
+			return fmt.Errorf("Error updating Billing Features for Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceArmApplicationInsightsRead(d, meta)
 }
 
 func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appInsightsClient
+	billingClient := meta.(*ArmClient).appInsightsBillingFeaturesClient
 	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(d.Id())
@@ -147,6 +184,15 @@ func resourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface{}
 		d.Set("instrumentation_key", props.InstrumentationKey)
 	}
 
+	billing, err := billingClient.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Billing Features for Application Insights %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if dataVolumeCap := billing.DataVolumeCap; dataVolumeCap != nil {
+		d.Set("daily_data_cap_in_gb", dataVolumeCap.Cap)
+		d.Set("daily_data_cap_notifications_disabled", dataVolumeCap.StopSendNotificationWhenHitCap)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
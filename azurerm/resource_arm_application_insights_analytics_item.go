@@ -0,0 +1,258 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsAnalyticsItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsAnalyticsItemCreateOrUpdate,
+		Read:   resourceArmApplicationInsightsAnalyticsItemRead,
+		Update: resourceArmApplicationInsightsAnalyticsItemCreateOrUpdate,
+		Delete: resourceArmApplicationInsightsAnalyticsItemDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_insights_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.Folder),
+					string(insights.Function),
+					string(insights.Query),
+					string(insights.Recent),
+				}, false),
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.ItemScopeShared),
+					string(insights.ItemScopeUser),
+				}, false),
+			},
+
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"function_alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"time_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsAnalyticsItemCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Application Insights Analytics Item creation.")
+
+	name := d.Get("name").(string)
+	appInsightsId := d.Get("application_insights_id").(string)
+	itemType := d.Get("type").(string)
+	scope := d.Get("scope").(string)
+	content := d.Get("content").(string)
+	functionAlias := d.Get("function_alias").(string)
+
+	id, err := parseAzureResourceID(appInsightsId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	if itemType == string(insights.Function) && functionAlias == "" {
+		return fmt.Errorf("`function_alias` must be specified when `type` is `function`")
+	}
+
+	item := insights.ApplicationInsightsComponentAnalyticsItem{
+		Name:    utils.String(name),
+		Type:    insights.ItemType(itemType),
+		Scope:   insights.ItemScope(scope),
+		Content: utils.String(content),
+	}
+
+	if functionAlias != "" {
+		item.Properties = &insights.ApplicationInsightsComponentAnalyticsItemProperties{
+			FunctionAlias: utils.String(functionAlias),
+		}
+	}
+
+	// when updating, the existing Item ID needs to be sent so that the item is updated in-place
+	if !d.IsNewResource() {
+		existing, err := parseApplicationInsightsAnalyticsItemId(d.Id())
+		if err != nil {
+			return err
+		}
+		item.ID = utils.String(existing.ItemID)
+	}
+
+	scopePath := applicationInsightsAnalyticsItemScopePath(scope)
+	result, err := client.Put(ctx, resourceGroup, appInsightsName, scopePath, item, utils.Bool(false))
+	if err != nil {
+		return fmt.Errorf("Error creating or updating Application Insights Analytics Item %q (Application Insights %q / Resource Group %q): %+v", name, appInsightsName, resourceGroup, err)
+	}
+	if result.ID == nil {
+		return fmt.Errorf("Cannot read Application Insights Analytics Item %q (Application Insights %q / Resource Group %q) ID", name, appInsightsName, resourceGroup)
+	}
+
+	// Analytics Items are identified by a GUID which is unique within the Application Insights component
+	// rather than a Resource ID, so we build one up from the component ID and the scope path
+	d.SetId(fmt.Sprintf("%s/%s/%s", appInsightsId, string(scopePath), *result.ID))
+
+	return resourceArmApplicationInsightsAnalyticsItemRead(d, meta)
+}
+
+func resourceArmApplicationInsightsAnalyticsItemRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseApplicationInsightsAnalyticsItemId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AppInsightsName, id.ScopePath, id.ItemID, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights Analytics Item %q was not found in Application Insights %q (Resource Group %q) - removing from state", id.ItemID, id.AppInsightsName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Insights Analytics Item %q (Application Insights %q / Resource Group %q): %+v", id.ItemID, id.AppInsightsName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("application_insights_id", id.ApplicationInsightsID)
+	d.Set("type", string(resp.Type))
+	d.Set("scope", string(resp.Scope))
+	d.Set("content", resp.Content)
+	d.Set("version", resp.Version)
+	d.Set("time_created", resp.TimeCreated)
+	d.Set("time_modified", resp.TimeModified)
+
+	functionAlias := ""
+	if props := resp.Properties; props != nil && props.FunctionAlias != nil {
+		functionAlias = *props.FunctionAlias
+	}
+	d.Set("function_alias", functionAlias)
+
+	return nil
+}
+
+func resourceArmApplicationInsightsAnalyticsItemDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseApplicationInsightsAnalyticsItemId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, id.ResourceGroup, id.AppInsightsName, id.ScopePath, id.ItemID, "")
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Application Insights Analytics Item %q (Application Insights %q / Resource Group %q): %+v", id.ItemID, id.AppInsightsName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func applicationInsightsAnalyticsItemScopePath(scope string) insights.ItemScopePath {
+	if scope == string(insights.ItemScopeUser) {
+		return insights.MyanalyticsItems
+	}
+
+	return insights.AnalyticsItems
+}
+
+type applicationInsightsAnalyticsItemId struct {
+	ResourceGroup         string
+	AppInsightsName       string
+	ApplicationInsightsID string
+	ScopePath             insights.ItemScopePath
+	ItemID                string
+}
+
+// the ID is in the format `{applicationInsightsId}/{analyticsItems|myanalyticsItems}/{itemId}`
+func parseApplicationInsightsAnalyticsItemId(input string) (*applicationInsightsAnalyticsItemId, error) {
+	segments := strings.Split(input, "/")
+	if len(segments) < 3 {
+		return nil, fmt.Errorf("Expected ID to be in the format `{applicationInsightsId}/{analyticsItems|myanalyticsItems}/{itemId}` - got %q", input)
+	}
+
+	itemId := segments[len(segments)-1]
+	scopePath := segments[len(segments)-2]
+	appInsightsId := strings.Join(segments[:len(segments)-2], "/")
+
+	if itemId == "" || (scopePath != string(insights.AnalyticsItems) && scopePath != string(insights.MyanalyticsItems)) {
+		return nil, fmt.Errorf("Expected ID to be in the format `{applicationInsightsId}/{analyticsItems|myanalyticsItems}/{itemId}` - got %q", input)
+	}
+
+	id, err := parseAzureResourceID(appInsightsId)
+	if err != nil {
+		return nil, err
+	}
+
+	appInsightsName := id.Path["components"]
+	if appInsightsName == "" {
+		return nil, fmt.Errorf("Expected ID to be in the format `{applicationInsightsId}/{analyticsItems|myanalyticsItems}/{itemId}` - got %q", input)
+	}
+
+	return &applicationInsightsAnalyticsItemId{
+		ResourceGroup:         id.ResourceGroup,
+		AppInsightsName:       appInsightsName,
+		ApplicationInsightsID: appInsightsId,
+		ScopePath:             insights.ItemScopePath(scopePath),
+		ItemID:                itemId,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseApplicationInsightsAnalyticsItemId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *applicationInsightsAnalyticsItemId
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/analyticsItems/",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/otherItems/item1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/analyticsItems/item1",
+			Expected: &applicationInsightsAnalyticsItemId{
+				ResourceGroup:         "group1",
+				AppInsightsName:       "component1",
+				ApplicationInsightsID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1",
+				ScopePath:             "analyticsItems",
+				ItemID:                "item1",
+			},
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/myanalyticsItems/item1",
+			Expected: &applicationInsightsAnalyticsItemId{
+				ResourceGroup:         "group1",
+				AppInsightsName:       "component1",
+				ApplicationInsightsID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1",
+				ScopePath:             "myanalyticsItems",
+				ItemID:                "item1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseApplicationInsightsAnalyticsItemId(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestAccAzureRMApplicationInsightsAnalyticsItem_query(t *testing.T) {
+	resourceName := "azurerm_application_insights_analytics_item.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAnalyticsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsightsAnalyticsItem_query(ri, location, "requests #test"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "query"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "shared"),
+					resource.TestCheckResourceAttr(resourceName, "content", "requests #test"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationInsightsAnalyticsItem_query(ri, location, "requests | take 10"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content", "requests | take 10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsAnalyticsItem_function(t *testing.T) {
+	resourceName := "azurerm_application_insights_analytics_item.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAnalyticsItem_function(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAnalyticsItemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "function"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "user"),
+					resource.TestCheckResourceAttr(resourceName, "function_alias", "myfunction"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsAnalyticsItemDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appInsightsAnalyticsItemsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights_analytics_item" {
+			continue
+		}
+
+		id, err := parseApplicationInsightsAnalyticsItemId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.AppInsightsName, id.ScopePath, id.ItemID, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Application Insights Analytics Item %q (Application Insights %q / Resource Group %q) still exists", id.ItemID, id.AppInsightsName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApplicationInsightsAnalyticsItemExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseApplicationInsightsAnalyticsItemId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appInsightsAnalyticsItemsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.AppInsightsName, id.ScopePath, id.ItemID, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Application Insights Analytics Item %q (Application Insights %q / Resource Group %q) does not exist", id.ItemID, id.AppInsightsName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on appInsightsAnalyticsItemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationInsightsAnalyticsItem_query(rInt int, location string, content string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_analytics_item" "test" {
+  name                    = "acctestappinsightsitem-%d"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  content                 = "%s"
+  scope                   = "shared"
+  type                    = "query"
+}
+`, rInt, location, rInt, rInt, content)
+}
+
+func testAccAzureRMApplicationInsightsAnalyticsItem_function(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_analytics_item" "test" {
+  name                    = "acctestappinsightsitem-%d"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  content                 = "requests #test"
+  scope                   = "user"
+  type                    = "function"
+  function_alias          = "myfunction"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,235 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsAPIKeyCreate,
+		Read:   resourceArmApplicationInsightsAPIKeyRead,
+		Delete: resourceArmApplicationInsightsAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"application_insights_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"read_permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"agentconfig",
+						"aggregate",
+						"api",
+						"draft",
+						"extendqueries",
+						"search",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"write_permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"annotations",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAPIKeyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Application Insights API Key creation.")
+
+	name := d.Get("name").(string)
+	appInsightsId := d.Get("application_insights_id").(string)
+
+	id, err := parseAzureResourceID(appInsightsId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.Path["components"]
+
+	linkedReadProperties := expandApplicationInsightsAPIKeyLinkedProperties(d.Get("read_permissions").(*schema.Set), appInsightsId)
+	linkedWriteProperties := expandApplicationInsightsAPIKeyLinkedProperties(d.Get("write_permissions").(*schema.Set), appInsightsId)
+	if len(*linkedReadProperties) == 0 && len(*linkedWriteProperties) == 0 {
+		return fmt.Errorf("At least one of `read_permissions` or `write_permissions` must be specified")
+	}
+
+	apiKeyProperties := insights.APIKeyRequest{
+		Name:                  utils.String(name),
+		LinkedReadProperties:  linkedReadProperties,
+		LinkedWriteProperties: linkedWriteProperties,
+	}
+
+	result, err := client.Create(ctx, resourceGroup, appInsightsName, apiKeyProperties)
+	if err != nil {
+		return fmt.Errorf("Error creating Application Insights API Key %q (Application Insights %q / Resource Group %q): %+v", name, appInsightsName, resourceGroup, err)
+	}
+	if result.ID == nil {
+		return fmt.Errorf("Cannot read Application Insights API Key %q (Application Insights %q / Resource Group %q) ID", name, appInsightsName, resourceGroup)
+	}
+
+	d.SetId(*result.ID)
+
+	// the API Key is only returned at creation time
+	d.Set("api_key", result.APIKey)
+
+	return resourceArmApplicationInsightsAPIKeyRead(d, meta)
+}
+
+func resourceArmApplicationInsightsAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAPIKeyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseApplicationInsightsAPIKeyId(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.AppInsightsName
+	keyId := id.KeyID
+
+	resp, err := client.Get(ctx, resourceGroup, appInsightsName, keyId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights API Key %q was not found in Application Insights %q (Resource Group %q) - removing from state", keyId, appInsightsName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Insights API Key %q (Application Insights %q / Resource Group %q): %+v", keyId, appInsightsName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("application_insights_id", id.ApplicationInsightsID)
+
+	if err := d.Set("read_permissions", flattenApplicationInsightsAPIKeyLinkedProperties(resp.LinkedReadProperties)); err != nil {
+		return fmt.Errorf("Error setting `read_permissions`: %+v", err)
+	}
+	if err := d.Set("write_permissions", flattenApplicationInsightsAPIKeyLinkedProperties(resp.LinkedWriteProperties)); err != nil {
+		return fmt.Errorf("Error setting `write_permissions`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApplicationInsightsAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsAPIKeyClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseApplicationInsightsAPIKeyId(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	appInsightsName := id.AppInsightsName
+	keyId := id.KeyID
+
+	resp, err := client.Delete(ctx, resourceGroup, appInsightsName, keyId)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Application Insights API Key %q (Application Insights %q / Resource Group %q): %+v", keyId, appInsightsName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// linked properties are in the format `{applicationInsightsId}/{permission}`
+func expandApplicationInsightsAPIKeyLinkedProperties(input *schema.Set, appInsightsId string) *[]string {
+	result := make([]string, 0)
+	for _, v := range input.List() {
+		result = append(result, fmt.Sprintf("%s/%s", appInsightsId, v.(string)))
+	}
+	return &result
+}
+
+func flattenApplicationInsightsAPIKeyLinkedProperties(input *[]string) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		segments := strings.Split(v, "/")
+		result = append(result, segments[len(segments)-1])
+	}
+	return result
+}
+
+type applicationInsightsAPIKeyId struct {
+	ResourceGroup         string
+	AppInsightsName       string
+	ApplicationInsightsID string
+	KeyID                 string
+}
+
+// the casing of the `apikeys` segment isn't consistent in the IDs returned from the API
+func parseApplicationInsightsAPIKeyId(input string) (*applicationInsightsAPIKeyId, error) {
+	index := strings.LastIndex(strings.ToLower(input), "/apikeys/")
+	if index == -1 {
+		return nil, fmt.Errorf("Expected ID to be in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/components/{name}/apikeys/{keyId}` - got %q", input)
+	}
+
+	appInsightsId := input[:index]
+	keyId := input[index+len("/apikeys/"):]
+
+	id, err := parseAzureResourceID(appInsightsId)
+	if err != nil {
+		return nil, err
+	}
+
+	appInsightsName := id.Path["components"]
+	if appInsightsName == "" || keyId == "" || strings.Contains(keyId, "/") {
+		return nil, fmt.Errorf("Expected ID to be in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/components/{name}/apikeys/{keyId}` - got %q", input)
+	}
+
+	return &applicationInsightsAPIKeyId{
+		ResourceGroup:         id.ResourceGroup,
+		AppInsightsName:       appInsightsName,
+		ApplicationInsightsID: appInsightsId,
+		KeyID:                 keyId,
+	}, nil
+}
@@ -0,0 +1,235 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseApplicationInsightsAPIKeyId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *applicationInsightsAPIKeyId
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/apikeys/",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/actionGroups/group1/apikeys/key1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/apikeys/key1",
+			Expected: &applicationInsightsAPIKeyId{
+				ResourceGroup:         "group1",
+				AppInsightsName:       "component1",
+				ApplicationInsightsID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1",
+				KeyID:                 "key1",
+			},
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1/APIKeys/key1",
+			Expected: &applicationInsightsAPIKeyId{
+				ResourceGroup:         "group1",
+				AppInsightsName:       "component1",
+				ApplicationInsightsID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/components/component1",
+				KeyID:                 "key1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseApplicationInsightsAPIKeyId(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestAccAzureRMApplicationInsightsAPIKey_readPermissions(t *testing.T) {
+	resourceName := "azurerm_application_insights_api_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAPIKey_basic(ri, testLocation(), `["aggregate", "api", "draft", "extendqueries", "search"]`, "[]")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_permissions.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "write_permissions.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsAPIKey_writePermissions(t *testing.T) {
+	resourceName := "azurerm_application_insights_api_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAPIKey_basic(ri, testLocation(), "[]", `["annotations"]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_permissions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "write_permissions.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsightsAPIKey_fullPermissions(t *testing.T) {
+	resourceName := "azurerm_application_insights_api_key.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsightsAPIKey_basic(ri, testLocation(), `["agentconfig", "aggregate", "api", "draft", "extendqueries", "search"]`, `["annotations"]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_permissions.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "write_permissions.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApplicationInsightsAPIKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appInsightsAPIKeyClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_application_insights_api_key" {
+			continue
+		}
+
+		id, err := parseApplicationInsightsAPIKeyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.AppInsightsName, id.KeyID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Application Insights API Key %q (Application Insights %q / Resource Group %q) still exists", id.KeyID, id.AppInsightsName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApplicationInsightsAPIKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseApplicationInsightsAPIKeyId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appInsightsAPIKeyClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.AppInsightsName, id.KeyID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Application Insights API Key %q (Application Insights %q / Resource Group %q) does not exist", id.KeyID, id.AppInsightsName, id.ResourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on appInsightsAPIKeyClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApplicationInsightsAPIKey_basic(rInt int, location, readPerms, writePerms string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_api_key" "test" {
+  name                    = "acctestappinsightsapikey-%d"
+  application_insights_id = "${azurerm_application_insights.test.id}"
+  read_permissions        = %s
+  write_permissions       = %s
+}
+`, rInt, location, rInt, rInt, readPerms, writePerms)
+}
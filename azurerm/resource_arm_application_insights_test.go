@@ -157,6 +157,41 @@ func TestAccAzureRMApplicationInsights_basiciOS(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMApplicationInsights_dailyDataCap(t *testing.T) {
+	resourceName := "azurerm_application_insights.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationInsights_dailyDataCap(ri, location, 5, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "daily_data_cap_in_gb", "5"),
+					resource.TestCheckResourceAttr(resourceName, "daily_data_cap_notifications_disabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationInsights_dailyDataCap(ri, location, 10, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "daily_data_cap_in_gb", "10"),
+					resource.TestCheckResourceAttr(resourceName, "daily_data_cap_notifications_disabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMApplicationInsightsDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).appInsightsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -228,3 +263,21 @@ resource "azurerm_application_insights" "test" {
 }
 `, rInt, location, rInt, applicationType)
 }
+
+func testAccAzureRMApplicationInsights_dailyDataCap(rInt int, location string, dataCap int, notificationsDisabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                                  = "acctestappinsights-%d"
+  location                              = "${azurerm_resource_group.test.location}"
+  resource_group_name                   = "${azurerm_resource_group.test.name}"
+  application_type                      = "web"
+  daily_data_cap_in_gb                  = %d
+  daily_data_cap_notifications_disabled = %t
+}
+`, rInt, location, rInt, dataCap, notificationsDisabled)
+}
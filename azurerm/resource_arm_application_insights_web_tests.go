@@ -0,0 +1,290 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2015-05-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsWebTest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsWebTestCreateOrUpdate,
+		Read:   resourceArmApplicationInsightsWebTestRead,
+		Update: resourceArmApplicationInsightsWebTestCreateOrUpdate,
+		Delete: resourceArmApplicationInsightsWebTestDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"application_insights_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.Multistep),
+					string(insights.Ping),
+				}, false),
+			},
+
+			"frequency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+				ValidateFunc: validateIntInSlice([]int{
+					300,
+					600,
+					900,
+				}),
+			},
+
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 120),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"retry_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"geo_locations": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"configuration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"synthetic_monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsWebTestCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Application Insights Web Test creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	appInsightsId := d.Get("application_insights_id").(string)
+
+	kind := d.Get("kind").(string)
+	description := d.Get("description").(string)
+	frequency := int32(d.Get("frequency").(int))
+	timeout := int32(d.Get("timeout").(int))
+	isEnabled := d.Get("enabled").(bool)
+	retryEnabled := d.Get("retry_enabled").(bool)
+	geoLocationsRaw := d.Get("geo_locations").([]interface{})
+	testConf := d.Get("configuration").(string)
+
+	// Web Tests are only displayed against an Application Insights component in the Portal
+	// when they carry a `hidden-link` tag referencing the component
+	tags := expandTags(d.Get("tags").(map[string]interface{}))
+	tags[fmt.Sprintf("hidden-link:%s", appInsightsId)] = utils.String("Resource")
+
+	webTest := insights.WebTest{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Kind:     insights.WebTestKind(kind),
+		WebTestProperties: &insights.WebTestProperties{
+			SyntheticMonitorID: utils.String(name),
+			WebTestName:        utils.String(name),
+			Description:        utils.String(description),
+			Enabled:            utils.Bool(isEnabled),
+			Frequency:          utils.Int32(frequency),
+			Timeout:            utils.Int32(timeout),
+			WebTestKind:        insights.WebTestKind(kind),
+			RetryEnabled:       utils.Bool(retryEnabled),
+			Locations:          expandApplicationInsightsWebTestGeoLocations(geoLocationsRaw),
+			Configuration: &insights.WebTestPropertiesConfiguration{
+				WebTest: utils.String(testConf),
+			},
+		},
+		Tags: tags,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, webTest); err != nil {
+		return fmt.Errorf("Error creating or updating Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Application Insights Web Test %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationInsightsWebTestRead(d, meta)
+}
+
+func resourceArmApplicationInsightsWebTestRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["webtests"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights Web Test %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("kind", string(resp.Kind))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	// the Application Insights component is only referenced via the `hidden-link` tag, which we strip
+	// from the user-facing tags
+	tags := make(map[string]*string)
+	for k, v := range resp.Tags {
+		if strings.HasPrefix(strings.ToLower(k), "hidden-link:") {
+			d.Set("application_insights_id", k[len("hidden-link:"):])
+			continue
+		}
+		tags[k] = v
+	}
+
+	if props := resp.WebTestProperties; props != nil {
+		d.Set("synthetic_monitor_id", props.SyntheticMonitorID)
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled)
+		d.Set("retry_enabled", props.RetryEnabled)
+
+		if frequency := props.Frequency; frequency != nil {
+			d.Set("frequency", int(*frequency))
+		}
+		if timeout := props.Timeout; timeout != nil {
+			d.Set("timeout", int(*timeout))
+		}
+
+		if config := props.Configuration; config != nil {
+			d.Set("configuration", config.WebTest)
+		}
+
+		if err := d.Set("geo_locations", flattenApplicationInsightsWebTestGeoLocations(props.Locations)); err != nil {
+			return fmt.Errorf("Error setting `geo_locations`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, tags)
+
+	return nil
+}
+
+func resourceArmApplicationInsightsWebTestDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["webtests"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Application Insights Web Test %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandApplicationInsightsWebTestGeoLocations(input []interface{}) *[]insights.WebTestGeolocation {
+	locations := make([]insights.WebTestGeolocation, 0)
+
+	for _, v := range input {
+		location := insights.WebTestGeolocation{
+			Location: utils.String(v.(string)),
+		}
+		locations = append(locations, location)
+	}
+
+	return &locations
+}
+
+func flattenApplicationInsightsWebTestGeoLocations(input *[]insights.WebTestGeolocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, prop := range *input {
+		if prop.Location != nil {
+			results = append(results, *prop.Location)
+		}
+	}
+
+	return results
+}
@@ -3,7 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
@@ -25,6 +28,8 @@ func resourceArmAutoScaleSetting() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmAutoScaleSettingCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -460,6 +465,298 @@ func resourceArmAutoScaleSettingDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceArmAutoScaleSettingCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	profiles := diff.Get("profile").([]interface{})
+
+	if err := validateAzureRmAutoScaleSettingProfiles(profiles); err != nil {
+		return err
+	}
+
+	// the remaining checks call the Azure API, which isn't possible without credentials
+	client, ok := v.(*ArmClient)
+	if !ok || client == nil {
+		return nil
+	}
+
+	if err := validateAzureRmAutoScaleSettingMetricNames(client, profiles); err != nil {
+		return err
+	}
+
+	// once the Autoscale Setting exists it keeps the Scale Set within the capacity of its profiles, so the current
+	// capacity of the Scale Set only conflicts with the capacity of a profile when it's being created
+	if diff.Id() == "" && diff.NewValueKnown("target_resource_id") {
+		checkAzureRmAutoScaleSettingScaleSetCapacity(client, diff, profiles)
+	}
+
+	return nil
+}
+
+// validateAzureRmAutoScaleSettingProfiles checks the capacity of each profile and that no two
+// profiles are scheduled to start at the same time, which the API otherwise resolves silently
+func validateAzureRmAutoScaleSettingProfiles(profiles []interface{}) error {
+	type fixedDateWindow struct {
+		profile string
+		start   time.Time
+		end     time.Time
+	}
+	fixedDates := make([]fixedDateWindow, 0)
+	recurrenceStarts := make(map[string]string)
+
+	for _, v := range profiles {
+		profile := v.(map[string]interface{})
+		name := profile["name"].(string)
+
+		if capacities := profile["capacity"].([]interface{}); len(capacities) > 0 && capacities[0] != nil {
+			capacity := capacities[0].(map[string]interface{})
+			minimum := capacity["minimum"].(int)
+			maximum := capacity["maximum"].(int)
+			defaultCapacity := capacity["default"].(int)
+
+			// these are validated to be at least 1, so a zero value means they're not known until apply
+			known := minimum > 0 && maximum > 0 && defaultCapacity > 0
+
+			if known && minimum > maximum {
+				return fmt.Errorf("The `minimum` capacity (%d) of Profile %q must be less than or equal to the `maximum` capacity (%d)", minimum, name, maximum)
+			}
+			if known && (defaultCapacity < minimum || defaultCapacity > maximum) {
+				return fmt.Errorf("The `default` capacity (%d) of Profile %q must be between the `minimum` (%d) and `maximum` (%d) capacity", defaultCapacity, name, minimum, maximum)
+			}
+		}
+
+		fixedDatesRaw := profile["fixed_date"].([]interface{})
+		recurrencesRaw := profile["recurrence"].([]interface{})
+		if len(fixedDatesRaw) > 0 && len(recurrencesRaw) > 0 {
+			return fmt.Errorf("Profile %q can specify either a `fixed_date` or a `recurrence` but not both", name)
+		}
+
+		if len(fixedDatesRaw) > 0 && fixedDatesRaw[0] != nil {
+			fixedDate := fixedDatesRaw[0].(map[string]interface{})
+
+			// these may not be known until apply (e.g. when interpolated), in which case they can't be checked
+			start, err := time.Parse(time.RFC3339, fixedDate["start"].(string))
+			if err != nil {
+				continue
+			}
+			end, err := time.Parse(time.RFC3339, fixedDate["end"].(string))
+			if err != nil {
+				continue
+			}
+
+			if !end.After(start) {
+				return fmt.Errorf("The `end` of the `fixed_date` in Profile %q must be after the `start`", name)
+			}
+
+			for _, other := range fixedDates {
+				if start.Before(other.end) && other.start.Before(end) {
+					return fmt.Errorf("The `fixed_date` of Profile %q overlaps with the `fixed_date` of Profile %q", name, other.profile)
+				}
+			}
+
+			fixedDates = append(fixedDates, fixedDateWindow{
+				profile: name,
+				start:   start,
+				end:     end,
+			})
+		}
+
+		if len(recurrencesRaw) > 0 && recurrencesRaw[0] != nil {
+			recurrence := recurrencesRaw[0].(map[string]interface{})
+			timeZone := recurrence["timezone"].(string)
+
+			hours := recurrence["hours"].([]interface{})
+			minutes := recurrence["minutes"].([]interface{})
+			if len(hours) == 0 || len(minutes) == 0 {
+				continue
+			}
+
+			for _, day := range recurrence["days"].([]interface{}) {
+				key := strings.ToLower(fmt.Sprintf("%s/%s/%02d:%02d", timeZone, day.(string), hours[0].(int), minutes[0].(int)))
+				if other, exists := recurrenceStarts[key]; exists {
+					return fmt.Errorf("The `recurrence` of Profile %q overlaps with the `recurrence` of Profile %q - both start on %s at %02d:%02d (%s)", name, other, day.(string), hours[0].(int), minutes[0].(int), timeZone)
+				}
+				recurrenceStarts[key] = name
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAzureRmAutoScaleSettingMetricNames checks each `metric_name` is emitted by the `metric_resource_id` within any
+// of its Metric Namespaces, so that a typo is surfaced at plan time rather than the rule silently never triggering
+func validateAzureRmAutoScaleSettingMetricNames(client *ArmClient, profiles []interface{}) error {
+
+	// resource ID -> lower-cased metric names, or nil if they couldn't be retrieved
+	available := make(map[string]map[string]string)
+
+	for _, v := range profiles {
+		profile := v.(map[string]interface{})
+
+		for _, r := range profile["rule"].([]interface{}) {
+			rule := r.(map[string]interface{})
+			triggers := rule["metric_trigger"].([]interface{})
+			if len(triggers) == 0 || triggers[0] == nil {
+				continue
+			}
+			trigger := triggers[0].(map[string]interface{})
+
+			// these are empty when they're not known until apply
+			metricName := trigger["metric_name"].(string)
+			resourceId := trigger["metric_resource_id"].(string)
+			if metricName == "" || resourceId == "" {
+				continue
+			}
+
+			metricNames, retrieved := available[strings.ToLower(resourceId)]
+			if !retrieved {
+				names, err := retrieveAzureRmAutoScaleSettingMetricNames(client, resourceId)
+				if err != nil {
+					// the resource may not exist yet, or the credentials may not be able to read it
+					log.Printf("[WARN] Unable to retrieve Metric Definitions for Resource %q - skipping validation of `metric_name`: %+v", resourceId, err)
+				}
+				metricNames = names
+				available[strings.ToLower(resourceId)] = metricNames
+			}
+
+			if metricNames == nil {
+				continue
+			}
+
+			if _, exists := metricNames[strings.ToLower(metricName)]; !exists {
+				names := make([]string, 0)
+				for _, name := range metricNames {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+
+				return fmt.Errorf("The Metric %q (Profile %q) isn't available for Resource %q - possible values are: %s", metricName, profile["name"].(string), resourceId, strings.Join(names, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// the Metrics emitted by a Resource are cached, since they're validated for each Autoscale Setting on every plan
+var (
+	autoScaleSettingMetricNamesCacheMu sync.RWMutex
+	autoScaleSettingMetricNamesCache   = make(map[string]map[string]string)
+)
+
+// retrieveAzureRmAutoScaleSettingMetricNames returns the lower-cased names of the Metrics emitted by the Resource across
+// all of its Metric Namespaces (e.g. including guest metrics), or nil if they couldn't all be retrieved
+func retrieveAzureRmAutoScaleSettingMetricNames(client *ArmClient, resourceId string) (map[string]string, error) {
+	cacheIndex := strings.ToLower(resourceId)
+	autoScaleSettingMetricNamesCacheMu.RLock()
+	metricNames, ok := autoScaleSettingMetricNamesCache[cacheIndex]
+	autoScaleSettingMetricNamesCacheMu.RUnlock()
+
+	if ok {
+		return metricNames, nil
+	}
+
+	metricNames, err := listAzureRmAutoScaleSettingMetricNames(client, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	autoScaleSettingMetricNamesCacheMu.Lock()
+	autoScaleSettingMetricNamesCache[cacheIndex] = metricNames
+	autoScaleSettingMetricNamesCacheMu.Unlock()
+
+	return metricNames, nil
+}
+
+func listAzureRmAutoScaleSettingMetricNames(client *ArmClient, resourceId string) (map[string]string, error) {
+	ctx := client.StopContext
+	resourceUri := strings.TrimPrefix(resourceId, "/")
+
+	namespaces, err := client.monitorMetricNamespacesClient.List(ctx, resourceUri, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Metric Namespaces: %+v", err)
+	}
+	if namespaces.Value == nil || len(*namespaces.Value) == 0 {
+		return nil, nil
+	}
+
+	metricNames := make(map[string]string)
+	for _, namespace := range *namespaces.Value {
+		if namespace.Properties == nil || namespace.Properties.MetricNamespaceName == nil {
+			continue
+		}
+		namespaceName := *namespace.Properties.MetricNamespaceName
+
+		resp, err := client.monitorMetricDefinitionsClient.List(ctx, resourceUri, namespaceName)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Metric Definitions for Namespace %q: %+v", namespaceName, err)
+		}
+		if resp.Value == nil {
+			continue
+		}
+
+		for _, definition := range *resp.Value {
+			if definition.Name != nil && definition.Name.Value != nil {
+				metricNames[strings.ToLower(*definition.Name.Value)] = *definition.Name.Value
+			}
+		}
+	}
+
+	return metricNames, nil
+}
+
+// checkAzureRmAutoScaleSettingScaleSetCapacity logs a warning when the current capacity of the target Virtual Machine Scale Set
+// falls outside of the capacity of the default profile, since Autoscale then resizes the Scale Set and Terraform reverts
+// `sku.0.capacity`. Profiles with a `fixed_date` or `recurrence` aren't checked, since they may not be active at the moment.
+func checkAzureRmAutoScaleSettingScaleSetCapacity(client *ArmClient, diff *schema.ResourceDiff, profiles []interface{}) {
+	id, err := parseAzureResourceID(diff.Get("target_resource_id").(string))
+	if err != nil {
+		return
+	}
+
+	scaleSetName, isScaleSet := id.Path["virtualMachineScaleSets"]
+	if !isScaleSet || !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return
+	}
+
+	for i, v := range profiles {
+		profile := v.(map[string]interface{})
+		if len(profile["fixed_date"].([]interface{})) > 0 || len(profile["recurrence"].([]interface{})) > 0 {
+			continue
+		}
+
+		capacities := profile["capacity"].([]interface{})
+		if len(capacities) == 0 || capacities[0] == nil {
+			continue
+		}
+
+		// values which aren't known until apply (e.g. interpolated from another resource) are zero at this point
+		if !diff.NewValueKnown(fmt.Sprintf("profile.%d.capacity.0.minimum", i)) || !diff.NewValueKnown(fmt.Sprintf("profile.%d.capacity.0.maximum", i)) {
+			continue
+		}
+
+		raw := capacities[0].(map[string]interface{})
+		minimum := raw["minimum"].(int)
+		maximum := raw["maximum"].(int)
+
+		scaleSet, err := client.vmScaleSetClient.Get(client.StopContext, id.ResourceGroup, scaleSetName)
+		if err != nil {
+			// the Scale Set may not exist yet, or the credentials may not be able to read it
+			log.Printf("[DEBUG] Unable to retrieve Virtual Machine Scale Set %q (Resource Group %q) - skipping validation of capacity: %+v", scaleSetName, id.ResourceGroup, err)
+			return
+		}
+		if scaleSet.Sku == nil || scaleSet.Sku.Capacity == nil {
+			return
+		}
+
+		capacity := int(*scaleSet.Sku.Capacity)
+		if capacity < minimum || capacity > maximum {
+			log.Printf("[WARN] The capacity (%d) of Virtual Machine Scale Set %q (Resource Group %q) is outside of the capacity of the default Profile %q (%d - %d) and will be changed by Autoscale, which Terraform may then revert.", capacity, scaleSetName, id.ResourceGroup, profile["name"].(string), minimum, maximum)
+		}
+
+		return
+	}
+}
+
 func expandAzureRmAutoScaleSettingProfile(input []interface{}) (*[]insights.AutoscaleProfile, error) {
 	results := make([]insights.AutoscaleProfile, 0)
 
@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -235,6 +237,170 @@ func TestAccAzureRMAutoScaleSetting_fixedDate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAutoScaleSetting_invalidMetricName(t *testing.T) {
+	resourceName := "azurerm_autoscale_setting.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+	config := testAccAzureRMAutoScaleSetting_basic(ri, rs, location)
+	invalidConfig := strings.Replace(config, `"Percentage CPU"`, `"Percentage CPUs"`, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutoScaleSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutoScaleSettingExists(resourceName),
+				),
+			},
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile("The Metric \"Percentage CPUs\" \\(Profile \"metricRules\"\\) isn't available"),
+			},
+		},
+	})
+}
+
+func TestValidateAzureRmAutoScaleSettingProfiles(t *testing.T) {
+	profile := func(name string, minimum, maximum, defaultCapacity int, fixedDate []interface{}, recurrence []interface{}) interface{} {
+		return map[string]interface{}{
+			"name": name,
+			"capacity": []interface{}{
+				map[string]interface{}{
+					"minimum": minimum,
+					"maximum": maximum,
+					"default": defaultCapacity,
+				},
+			},
+			"fixed_date": fixedDate,
+			"recurrence": recurrence,
+		}
+	}
+	fixedDate := func(start, end string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"timezone": "UTC",
+				"start":    start,
+				"end":      end,
+			},
+		}
+	}
+	recurrence := func(timeZone string, days []interface{}, hour, minute int) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"timezone": timeZone,
+				"days":     days,
+				"hours":    []interface{}{hour},
+				"minutes":  []interface{}{minute},
+			},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Profiles []interface{}
+		Error    bool
+	}{
+		{
+			Name: "Default Profile",
+			Profiles: []interface{}{
+				profile("default", 1, 10, 2, []interface{}{}, []interface{}{}),
+			},
+			Error: false,
+		},
+		{
+			Name: "Minimum Greater Than Maximum",
+			Profiles: []interface{}{
+				profile("default", 5, 2, 2, []interface{}{}, []interface{}{}),
+			},
+			Error: true,
+		},
+		{
+			Name: "Default Outside Of Range",
+			Profiles: []interface{}{
+				profile("default", 1, 10, 11, []interface{}{}, []interface{}{}),
+			},
+			Error: true,
+		},
+		{
+			Name: "Unknown Capacity",
+			Profiles: []interface{}{
+				profile("default", 0, 0, 0, []interface{}{}, []interface{}{}),
+			},
+			Error: false,
+		},
+		{
+			Name: "Fixed Date And Recurrence",
+			Profiles: []interface{}{
+				profile("both", 1, 10, 2, fixedDate("2020-06-18T00:00:00Z", "2020-06-19T00:00:00Z"), recurrence("UTC", []interface{}{"Monday"}, 18, 0)),
+			},
+			Error: true,
+		},
+		{
+			Name: "Fixed Date Ends Before Start",
+			Profiles: []interface{}{
+				profile("backwards", 1, 10, 2, fixedDate("2020-06-19T00:00:00Z", "2020-06-18T00:00:00Z"), []interface{}{}),
+			},
+			Error: true,
+		},
+		{
+			Name: "Separate Fixed Dates",
+			Profiles: []interface{}{
+				profile("first", 1, 10, 2, fixedDate("2020-06-18T00:00:00Z", "2020-06-19T00:00:00Z"), []interface{}{}),
+				profile("second", 1, 10, 2, fixedDate("2020-06-19T00:00:00Z", "2020-06-20T00:00:00Z"), []interface{}{}),
+			},
+			Error: false,
+		},
+		{
+			Name: "Overlapping Fixed Dates",
+			Profiles: []interface{}{
+				profile("first", 1, 10, 2, fixedDate("2020-06-18T00:00:00Z", "2020-06-19T12:00:00Z"), []interface{}{}),
+				profile("second", 1, 10, 2, fixedDate("2020-06-19T00:00:00Z", "2020-06-20T00:00:00Z"), []interface{}{}),
+			},
+			Error: true,
+		},
+		{
+			Name: "Separate Recurrences",
+			Profiles: []interface{}{
+				profile("weekdays", 1, 10, 2, []interface{}{}, recurrence("UTC", []interface{}{"Monday", "Tuesday"}, 8, 0)),
+				profile("evenings", 1, 10, 2, []interface{}{}, recurrence("UTC", []interface{}{"Monday", "Tuesday"}, 18, 0)),
+			},
+			Error: false,
+		},
+		{
+			Name: "Overlapping Recurrences",
+			Profiles: []interface{}{
+				profile("weekdays", 1, 10, 2, []interface{}{}, recurrence("UTC", []interface{}{"Monday", "Tuesday"}, 8, 0)),
+				profile("tuesdays", 1, 10, 2, []interface{}{}, recurrence("UTC", []interface{}{"tuesday"}, 8, 0)),
+			},
+			Error: true,
+		},
+		{
+			Name: "Recurrences In Different Time Zones",
+			Profiles: []interface{}{
+				profile("utc", 1, 10, 2, []interface{}{}, recurrence("UTC", []interface{}{"Monday"}, 8, 0)),
+				profile("pacific", 1, 10, 2, []interface{}{}, recurrence("Pacific Standard Time", []interface{}{"Monday"}, 8, 0)),
+			},
+			Error: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateAzureRmAutoScaleSettingProfiles(v.Profiles)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
 func testCheckAzureRMAutoScaleSettingExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsDataSourceWindowsEvent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsDataSourceWindowsEventCreateUpdate,
+		Read:   resourceArmLogAnalyticsDataSourceWindowsEventRead,
+		Update: resourceArmLogAnalyticsDataSourceWindowsEventCreateUpdate,
+		Delete: resourceArmLogAnalyticsDataSourceWindowsEventDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"event_log_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"event_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"error",
+						"information",
+						"warning",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+type logAnalyticsDataSourceWindowsEventProperties struct {
+	EventLogName string                                   `json:"eventLogName"`
+	EventTypes   []logAnalyticsDataSourceWindowsEventType `json:"eventTypes"`
+}
+
+type logAnalyticsDataSourceWindowsEventType struct {
+	EventType string `json:"eventType"`
+}
+
+func resourceArmLogAnalyticsDataSourceWindowsEventCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Windows Event Data Source creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+
+	eventTypes := make([]logAnalyticsDataSourceWindowsEventType, 0)
+	for _, v := range d.Get("event_types").(*schema.Set).List() {
+		eventTypes = append(eventTypes, logAnalyticsDataSourceWindowsEventType{
+			EventType: v.(string),
+		})
+	}
+
+	parameters := operationalinsights.DataSource{
+		Kind: operationalinsights.WindowsEvent,
+		Properties: &logAnalyticsDataSourceWindowsEventProperties{
+			EventLogName: d.Get("event_log_name").(string),
+			EventTypes:   eventTypes,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Log Analytics Windows Event Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Windows Event Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Windows Event Data Source %q (Workspace %q / Resource Group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogAnalyticsDataSourceWindowsEventRead(d, meta)
+}
+
+func resourceArmLogAnalyticsDataSourceWindowsEventRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "dataSources")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Log Analytics Windows Event Data Source %q was not found in Workspace %q (Resource Group %q) - removing from state", name, workspaceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Log Analytics Windows Event Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+
+	if resp.Properties != nil {
+		// the Properties are returned as a generic JSON object, so we round-trip them into the typed struct
+		propsJson, err := json.Marshal(resp.Properties)
+		if err != nil {
+			return fmt.Errorf("Error serializing properties for Log Analytics Windows Event Data Source %q: %+v", name, err)
+		}
+
+		var props logAnalyticsDataSourceWindowsEventProperties
+		if err := json.Unmarshal(propsJson, &props); err != nil {
+			return fmt.Errorf("Error deserializing properties for Log Analytics Windows Event Data Source %q: %+v", name, err)
+		}
+
+		d.Set("event_log_name", props.EventLogName)
+
+		// the API returns the Event Types in Title Case
+		eventTypes := make([]interface{}, 0)
+		for _, v := range props.EventTypes {
+			eventTypes = append(eventTypes, strings.ToLower(v.EventType))
+		}
+		if err := d.Set("event_types", eventTypes); err != nil {
+			return fmt.Errorf("Error setting `event_types`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsDataSourceWindowsEventDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "dataSources")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Log Analytics Windows Event Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsDataSourceWindowsEvent_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_datasource_windows_event.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsDataSourceWindowsEvent_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsDataSourceWindowsEventDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsDataSourceWindowsEventExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_log_name", "Application"),
+					resource.TestCheckResourceAttr(resourceName, "event_types.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsDataSourceWindowsEvent_update(t *testing.T) {
+	resourceName := "azurerm_log_analytics_datasource_windows_event.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsDataSourceWindowsEventDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogAnalyticsDataSourceWindowsEvent_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsDataSourceWindowsEventExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_log_name", "Application"),
+					resource.TestCheckResourceAttr(resourceName, "event_types.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogAnalyticsDataSourceWindowsEvent_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsDataSourceWindowsEventExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_log_name", "System"),
+					resource.TestCheckResourceAttr(resourceName, "event_types.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsDataSourceWindowsEventDestroy(s *terraform.State) error {
+	return testCheckAzureRMLogAnalyticsDataSourceDestroy(s, "azurerm_log_analytics_datasource_windows_event")
+}
+
+func testCheckAzureRMLogAnalyticsDataSourceWindowsEventExists(resourceName string) resource.TestCheckFunc {
+	return testCheckAzureRMLogAnalyticsDataSourceExists(resourceName)
+}
+
+// shared between the Windows Event and Windows Performance Counter Data Source tests
+func testCheckAzureRMLogAnalyticsDataSourceDestroy(s *terraform.State, resourceType string) error {
+	client := testAccProvider.Meta().(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Log Analytics Data Source %q (Workspace %q / Resource Group %q) still exists", name, workspaceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsDataSourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).logAnalyticsDataSourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Log Analytics Data Source %q (Workspace %q / Resource Group %q) does not exist", name, workspaceName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on logAnalyticsDataSourcesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsDataSourceWindowsEvent_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_datasource_windows_event" "test" {
+  name                = "acctestLADS-WE-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  event_log_name      = "Application"
+  event_types         = ["error"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMLogAnalyticsDataSourceWindowsEvent_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_datasource_windows_event" "test" {
+  name                = "acctestLADS-WE-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  event_log_name      = "System"
+  event_types         = ["error", "information", "warning"]
+}
+`, rInt, location, rInt, rInt)
+}
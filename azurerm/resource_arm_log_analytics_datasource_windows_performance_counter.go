@@ -0,0 +1,177 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate,
+		Read:   resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterRead,
+		Update: resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate,
+		Delete: resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"object_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"instance_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"counter_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"interval_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(10, math.MaxInt32),
+			},
+		},
+	}
+}
+
+type logAnalyticsDataSourceWindowsPerformanceCounterProperties struct {
+	ObjectName      string `json:"objectName"`
+	InstanceName    string `json:"instanceName"`
+	CounterName     string `json:"counterName"`
+	IntervalSeconds int    `json:"intervalSeconds"`
+}
+
+func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Windows Performance Counter Data Source creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+
+	parameters := operationalinsights.DataSource{
+		Kind: operationalinsights.WindowsPerformanceCounter,
+		Properties: &logAnalyticsDataSourceWindowsPerformanceCounterProperties{
+			ObjectName:      d.Get("object_name").(string),
+			InstanceName:    d.Get("instance_name").(string),
+			CounterName:     d.Get("counter_name").(string),
+			IntervalSeconds: d.Get("interval_seconds").(int),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Log Analytics Windows Performance Counter Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Windows Performance Counter Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Windows Performance Counter Data Source %q (Workspace %q / Resource Group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterRead(d, meta)
+}
+
+func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "dataSources")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Log Analytics Windows Performance Counter Data Source %q was not found in Workspace %q (Resource Group %q) - removing from state", name, workspaceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Log Analytics Windows Performance Counter Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+
+	if resp.Properties != nil {
+		// the Properties are returned as a generic JSON object, so we round-trip them into the typed struct
+		propsJson, err := json.Marshal(resp.Properties)
+		if err != nil {
+			return fmt.Errorf("Error serializing properties for Log Analytics Windows Performance Counter Data Source %q: %+v", name, err)
+		}
+
+		var props logAnalyticsDataSourceWindowsPerformanceCounterProperties
+		if err := json.Unmarshal(propsJson, &props); err != nil {
+			return fmt.Errorf("Error deserializing properties for Log Analytics Windows Performance Counter Data Source %q: %+v", name, err)
+		}
+
+		d.Set("object_name", props.ObjectName)
+		d.Set("instance_name", props.InstanceName)
+		d.Set("counter_name", props.CounterName)
+		d.Set("interval_seconds", props.IntervalSeconds)
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsDataSourceWindowsPerformanceCounterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsDataSourcesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "dataSources")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Log Analytics Windows Performance Counter Data Source %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
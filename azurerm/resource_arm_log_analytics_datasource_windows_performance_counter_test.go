@@ -0,0 +1,99 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLogAnalyticsDataSourceWindowsPerformanceCounter_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_datasource_windows_performance_counter.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsDataSourceWindowsPerformanceCounter_basic(ri, testLocation(), "% Processor Time", 10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsDataSourceWindowsPerformanceCounterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "object_name", "CPU"),
+					resource.TestCheckResourceAttr(resourceName, "instance_name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "counter_name", "% Processor Time"),
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsDataSourceWindowsPerformanceCounter_update(t *testing.T) {
+	resourceName := "azurerm_log_analytics_datasource_windows_performance_counter.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsDataSourceWindowsPerformanceCounterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogAnalyticsDataSourceWindowsPerformanceCounter_basic(ri, location, "% Processor Time", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "counter_name", "% Processor Time"),
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "10"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogAnalyticsDataSourceWindowsPerformanceCounter_basic(ri, location, "% Idle Time", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsDataSourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "counter_name", "% Idle Time"),
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsDataSourceWindowsPerformanceCounterDestroy(s *terraform.State) error {
+	return testCheckAzureRMLogAnalyticsDataSourceDestroy(s, "azurerm_log_analytics_datasource_windows_performance_counter")
+}
+
+func testAccAzureRMLogAnalyticsDataSourceWindowsPerformanceCounter_basic(rInt int, location string, counterName string, interval int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_datasource_windows_performance_counter" "test" {
+  name                = "acctestLADS-WPC-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  object_name         = "CPU"
+  instance_name       = "*"
+  counter_name        = "%s"
+  interval_seconds    = %d
+}
+`, rInt, location, rInt, rInt, counterName, interval)
+}
@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+)
+
+func resourceArmLogAnalyticsIntelligencePack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsIntelligencePackCreate,
+		Read:   resourceArmLogAnalyticsIntelligencePackRead,
+		Delete: resourceArmLogAnalyticsIntelligencePackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsIntelligencePackCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).workspacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Intelligence Pack creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+
+	workspace, err := client.Get(ctx, resGroup, workspaceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Workspace %q (Resource Group %q): %+v", workspaceName, resGroup, err)
+	}
+	if workspace.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Workspace %q (Resource Group %q) ID", workspaceName, resGroup)
+	}
+
+	if _, err := client.EnableIntelligencePack(ctx, resGroup, workspaceName, name); err != nil {
+		return fmt.Errorf("Error enabling Log Analytics Intelligence Pack %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	// Intelligence Packs aren't a resource in their own right, they're toggled on the Workspace - as such we
+	// build up an ID from the Workspace ID and the name of the Intelligence Pack
+	d.SetId(fmt.Sprintf("%s/intelligencePacks/%s", *workspace.ID, name))
+
+	return resourceArmLogAnalyticsIntelligencePackRead(d, meta)
+}
+
+func resourceArmLogAnalyticsIntelligencePackRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).workspacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "intelligencePacks")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListIntelligencePacks(ctx, resGroup, workspaceName)
+	if err != nil {
+		if response.WasNotFound(resp.Response.Response) {
+			log.Printf("[DEBUG] Log Analytics Workspace %q was not found in Resource Group %q - removing Intelligence Pack %q from state", workspaceName, resGroup, name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing Intelligence Packs for Log Analytics Workspace %q (Resource Group %q): %+v", workspaceName, resGroup, err)
+	}
+
+	enabled := false
+	if packs := resp.Value; packs != nil {
+		for _, pack := range *packs {
+			if pack.Name == nil || !strings.EqualFold(*pack.Name, name) {
+				continue
+			}
+
+			if pack.Enabled != nil && *pack.Enabled {
+				enabled = true
+				name = *pack.Name
+				d.Set("display_name", pack.DisplayName)
+			}
+			break
+		}
+	}
+
+	if !enabled {
+		log.Printf("[DEBUG] Log Analytics Intelligence Pack %q is not enabled on Workspace %q (Resource Group %q) - removing from state", name, workspaceName, resGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+
+	return nil
+}
+
+func resourceArmLogAnalyticsIntelligencePackDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).workspacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "intelligencePacks")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DisableIntelligencePack(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error disabling Log Analytics Intelligence Pack %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
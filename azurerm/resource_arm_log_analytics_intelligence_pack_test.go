@@ -0,0 +1,133 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsIntelligencePack_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_intelligence_pack.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsIntelligencePack_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsIntelligencePackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsIntelligencePackEnabled(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "name", "ChangeTracking"),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsIntelligencePackDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).workspacesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_intelligence_pack" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		resp, err := client.ListIntelligencePacks(ctx, resourceGroup, workspaceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		if testAzureRMLogAnalyticsIntelligencePackIsEnabled(resp, name) {
+			return fmt.Errorf("Log Analytics Intelligence Pack %q (Workspace %q / Resource Group %q) is still enabled", name, workspaceName, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsIntelligencePackEnabled(resourceName string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).workspacesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.ListIntelligencePacks(ctx, resourceGroup, workspaceName)
+		if err != nil {
+			return fmt.Errorf("Bad: ListIntelligencePacks on workspacesClient: %+v", err)
+		}
+
+		if testAzureRMLogAnalyticsIntelligencePackIsEnabled(resp, name) != expected {
+			return fmt.Errorf("Bad: expected Log Analytics Intelligence Pack %q (Workspace %q / Resource Group %q) enabled to be %t", name, workspaceName, resourceGroup, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAzureRMLogAnalyticsIntelligencePackIsEnabled(resp operationalinsights.ListIntelligencePack, name string) bool {
+	if resp.Value == nil {
+		return false
+	}
+
+	for _, pack := range *resp.Value {
+		if pack.Name != nil && strings.EqualFold(*pack.Name, name) {
+			return pack.Enabled != nil && *pack.Enabled
+		}
+	}
+
+	return false
+}
+
+func testAccAzureRMLogAnalyticsIntelligencePack_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_intelligence_pack" "test" {
+  name                = "ChangeTracking"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsLinkedService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsLinkedServiceCreateUpdate,
+		Read:   resourceArmLogAnalyticsLinkedServiceRead,
+		Update: resourceArmLogAnalyticsLinkedServiceCreateUpdate,
+		Delete: resourceArmLogAnalyticsLinkedServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"linked_service_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "automation",
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validation.NoZeroValues,
+			},
+
+			"resource_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     azure.ValidateResourceID,
+			},
+
+			// Exported properties
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmLogAnalyticsLinkedServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).linkedServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Linked Service creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+	linkedServiceName := d.Get("linked_service_name").(string)
+	resourceId := d.Get("resource_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := operationalinsights.LinkedService{
+		LinkedServiceProperties: &operationalinsights.LinkedServiceProperties{
+			ResourceID: utils.String(resourceId),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resGroup, workspaceName, linkedServiceName, parameters); err != nil {
+		return fmt.Errorf("Error creating Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, workspaceName, linkedServiceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Linked Service %q (Workspace %q / Resource Group %q) ID", linkedServiceName, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogAnalyticsLinkedServiceRead(d, meta)
+}
+
+func resourceArmLogAnalyticsLinkedServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).linkedServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, linkedServiceName, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "linkedServices")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resGroup, workspaceName, linkedServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Log Analytics Linked Service %q was not found in Workspace %q (Resource Group %q) - removing from state", linkedServiceName, workspaceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+	d.Set("linked_service_name", linkedServiceName)
+
+	if props := resp.LinkedServiceProperties; props != nil {
+		d.Set("resource_id", props.ResourceID)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmLogAnalyticsLinkedServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).linkedServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, linkedServiceName, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "linkedServices")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resGroup, workspaceName, linkedServiceName)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Log Analytics Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsLinkedService_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_linked_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsLinkedService_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsLinkedServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctestlaw-%d/Automation", ri)),
+					resource.TestCheckResourceAttr(resourceName, "workspace_name", fmt.Sprintf("acctestlaw-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "linked_service_name", "automation"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsLinkedService_complete(t *testing.T) {
+	resourceName := "azurerm_log_analytics_linked_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsLinkedService_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsLinkedServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linked_service_name", "automation"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsLinkedServiceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).linkedServicesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_linked_service" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+		linkedServiceName := rs.Primary.Attributes["linked_service_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName, linkedServiceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Log Analytics Linked Service %q (Workspace %q / Resource Group %q) still exists", linkedServiceName, workspaceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+		linkedServiceName := rs.Primary.Attributes["linked_service_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).linkedServicesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, workspaceName, linkedServiceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Log Analytics Linked Service %q (Workspace %q / Resource Group %q) does not exist", linkedServiceName, workspaceName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on linkedServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAutomation-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_basic(rInt int, location string) string {
+	template := testAccAzureRMLogAnalyticsLinkedService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_linked_service" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  resource_id         = "${azurerm_automation_account.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_complete(rInt int, location string) string {
+	template := testAccAzureRMLogAnalyticsLinkedService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_linked_service" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  linked_service_name = "automation"
+  resource_id         = "${azurerm_automation_account.test.id}"
+
+  tags {
+    environment = "test"
+  }
+}
+`, template)
+}
@@ -0,0 +1,264 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	logAnalytics "github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2015-03-20/operationalinsights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsSavedSearch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsSavedSearchCreateUpdate,
+		Read:   resourceArmLogAnalyticsSavedSearchRead,
+		Update: resourceArmLogAnalyticsSavedSearchCreateUpdate,
+		Delete: resourceArmLogAnalyticsSavedSearchDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"category": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"function_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"function_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsSavedSearchCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsSavedSearchesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Analytics Saved Search creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+
+	properties := logAnalyticsSavedSearchProperties{
+		SavedSearchProperties: logAnalytics.SavedSearchProperties{
+			Category:    utils.String(d.Get("category").(string)),
+			DisplayName: utils.String(d.Get("display_name").(string)),
+			Query:       utils.String(d.Get("query").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("function_alias"); ok {
+		properties.FunctionAlias = utils.String(v.(string))
+	}
+
+	// the Function Parameters are a single comma separated string in the API, e.g. `a:string = "b", c:int = 1`
+	functionParameters := make([]string, 0)
+	for _, v := range d.Get("function_parameters").([]interface{}) {
+		functionParameters = append(functionParameters, v.(string))
+	}
+	if len(functionParameters) > 0 {
+		properties.FunctionParameters = utils.String(strings.Join(functionParameters, ", "))
+	}
+
+	parameters := logAnalyticsSavedSearch{
+		Properties: &properties,
+	}
+
+	if err := createOrUpdateLogAnalyticsSavedSearch(ctx, client, resGroup, workspaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Log Analytics Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := getLogAnalyticsSavedSearch(ctx, client, resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Saved Search %q (Workspace %q / Resource Group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogAnalyticsSavedSearchRead(d, meta)
+}
+
+func resourceArmLogAnalyticsSavedSearchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsSavedSearchesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "savedSearches")
+	if err != nil {
+		return err
+	}
+
+	resp, err := getLogAnalyticsSavedSearch(ctx, client, resGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Log Analytics Saved Search %q was not found in Workspace %q (Resource Group %q) - removing from state", name, workspaceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Log Analytics Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("category", props.Category)
+		d.Set("display_name", props.DisplayName)
+		d.Set("query", props.Query)
+		d.Set("function_alias", props.FunctionAlias)
+
+		functionParameters := make([]interface{}, 0)
+		if v := props.FunctionParameters; v != nil && *v != "" {
+			for _, parameter := range strings.Split(*v, ",") {
+				functionParameters = append(functionParameters, strings.TrimSpace(parameter))
+			}
+		}
+		if err := d.Set("function_parameters", functionParameters); err != nil {
+			return fmt.Errorf("Error setting `function_parameters`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsSavedSearchDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logAnalyticsSavedSearchesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(d.Id(), "savedSearches")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resGroup, workspaceName, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Log Analytics Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// NOTE: the SDK doesn't model the Function Alias or Function Parameters of a Saved Search - as such these requests are
+// sent using the SDK's client, but the Saved Search is (un)marshalled using the types below.
+
+type logAnalyticsSavedSearch struct {
+	autorest.Response `json:"-"`
+	ID                *string                            `json:"id,omitempty"`
+	Properties        *logAnalyticsSavedSearchProperties `json:"properties,omitempty"`
+}
+
+type logAnalyticsSavedSearchProperties struct {
+	logAnalytics.SavedSearchProperties
+	FunctionAlias      *string `json:"functionAlias,omitempty"`
+	FunctionParameters *string `json:"functionParameters,omitempty"`
+}
+
+func createOrUpdateLogAnalyticsSavedSearch(ctx context.Context, client logAnalytics.SavedSearchesClient, resourceGroup string, workspaceName string, name string, parameters logAnalyticsSavedSearch) error {
+	req, err := client.CreateOrUpdatePreparer(ctx, resourceGroup, workspaceName, name, logAnalytics.SavedSearch{})
+	if err == nil {
+		req, err = autorest.Prepare(req, autorest.WithJSON(parameters))
+	}
+	if err != nil {
+		return autorest.NewErrorWithError(err, "operationalinsights.SavedSearchesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "operationalinsights.SavedSearchesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "operationalinsights.SavedSearchesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func getLogAnalyticsSavedSearch(ctx context.Context, client logAnalytics.SavedSearchesClient, resourceGroup string, workspaceName string, name string) (result logAnalyticsSavedSearch, err error) {
+	req, err := client.GetPreparer(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.SavedSearchesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "operationalinsights.SavedSearchesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "operationalinsights.SavedSearchesClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,173 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMLogAnalyticsSavedSearch_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_saved_search.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsSavedSearch_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSavedSearchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSavedSearchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "Saved Searches"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Heartbeats"),
+					resource.TestCheckResourceAttr(resourceName, "function_parameters.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsSavedSearch_function(t *testing.T) {
+	resourceName := "azurerm_log_analytics_saved_search.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsSavedSearch_function(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSavedSearchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSavedSearchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "function_alias", "heartbeats_since"),
+					resource.TestCheckResourceAttr(resourceName, "function_parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "function_parameters.0", "computer:string = '*'"),
+					resource.TestCheckResourceAttr(resourceName, "function_parameters.1", "since:timespan = 1h"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsSavedSearchDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).logAnalyticsSavedSearchesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_saved_search" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		resp, err := getLogAnalyticsSavedSearch(ctx, client, resourceGroup, workspaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Log Analytics Saved Search %q (Workspace %q / Resource Group %q) still exists", name, workspaceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsSavedSearchExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).logAnalyticsSavedSearchesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := getLogAnalyticsSavedSearch(ctx, client, resourceGroup, workspaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Log Analytics Saved Search %q (Workspace %q / Resource Group %q) does not exist", name, workspaceName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on logAnalyticsSavedSearchesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsSavedSearch_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_saved_search" "test" {
+  name                = "acctestLASS-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  category            = "Saved Searches"
+  display_name        = "Heartbeats"
+  query               = "Heartbeat | summarize count() by Computer"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMLogAnalyticsSavedSearch_function(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_saved_search" "test" {
+  name                = "acctestLASS-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  category            = "Functions"
+  display_name        = "Heartbeats Since"
+  query               = "Heartbeat | where Computer matches regex computer and TimeGenerated > ago(since)"
+  function_alias      = "heartbeats_since"
+  function_parameters = ["computer:string = '*'", "since:timespan = 1h"]
+}
+`, rInt, location, rInt, rInt)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -218,3 +219,29 @@ func validateAzureRmLogAnalyticsWorkspaceName(v interface{}, k string) (ws []str
 
 	return
 }
+
+// parseLogAnalyticsWorkspaceChildId parses the ID of a resource nested within a Log Analytics Workspace, returning the
+// Resource Group, Workspace Name and Name. The API returns some of these IDs lower-cased, so segments are matched
+// case-insensitively.
+func parseLogAnalyticsWorkspaceChildId(input string, segment string) (string, string, string, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var workspaceName, name string
+	for key, value := range id.Path {
+		if strings.EqualFold(key, "workspaces") {
+			workspaceName = value
+		}
+		if strings.EqualFold(key, segment) {
+			name = value
+		}
+	}
+
+	if workspaceName == "" || name == "" {
+		return "", "", "", fmt.Errorf("Expected ID to be in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/%s/{name}` - got %q", segment, input)
+	}
+
+	return id.ResourceGroup, workspaceName, name, nil
+}
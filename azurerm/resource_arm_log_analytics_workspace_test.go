@@ -10,6 +10,73 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestParseLogAnalyticsWorkspaceChildId(t *testing.T) {
+	testData := []struct {
+		Input             string
+		Segment           string
+		ExpectError       bool
+		ExpectedWorkspace string
+		ExpectedName      string
+	}{
+		{
+			Input:       "",
+			Segment:     "linkedServices",
+			ExpectError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1",
+			Segment:     "linkedServices",
+			ExpectError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1/dataSources/source1",
+			Segment:     "linkedServices",
+			ExpectError: true,
+		},
+		{
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedServices/Automation",
+			Segment:           "linkedServices",
+			ExpectedWorkspace: "workspace1",
+			ExpectedName:      "Automation",
+		},
+		{
+			Input:             "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.operationalinsights/workspaces/workspace1/datasources/source1",
+			Segment:           "dataSources",
+			ExpectedWorkspace: "workspace1",
+			ExpectedName:      "source1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		resourceGroup, workspaceName, name, err := parseLogAnalyticsWorkspaceChildId(v.Input, v.Segment)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected %q to parse but got an error: %+v", v.Input, err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected %q to fail to parse", v.Input)
+		}
+
+		if resourceGroup != "group1" {
+			t.Fatalf("Expected the Resource Group to be %q but got %q", "group1", resourceGroup)
+		}
+
+		if workspaceName != v.ExpectedWorkspace {
+			t.Fatalf("Expected the Workspace Name to be %q but got %q", v.ExpectedWorkspace, workspaceName)
+		}
+
+		if name != v.ExpectedName {
+			t.Fatalf("Expected the Name to be %q but got %q", v.ExpectedName, name)
+		}
+	}
+}
+
 func TestAccAzureRmLogAnalyticsWorkspaceName_validation(t *testing.T) {
 	str := acctest.RandString(63)
 	cases := []struct {
@@ -16,12 +16,14 @@ func resourceArmManagedDisk() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmManagedDiskCreate,
 		Read:   resourceArmManagedDiskRead,
-		Update: resourceArmManagedDiskCreate,
+		Update: resourceArmManagedDiskUpdate,
 		Delete: resourceArmManagedDiskDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmManagedDiskCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -109,6 +111,22 @@ func validateDiskSizeGB(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func resourceArmManagedDiskCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	return validateDiskSizeGBCanOnlyBeIncreased(diff)
+}
+
+// disks (and snapshots) can only be grown - so we surface this at plan time rather than failing part way through an apply
+func validateDiskSizeGBCanOnlyBeIncreased(diff *schema.ResourceDiff) error {
+	if diff.Id() != "" && diff.HasChange("disk_size_gb") {
+		old, new := diff.GetChange("disk_size_gb")
+		if newSize := new.(int); newSize > 0 && newSize < old.(int) {
+			return fmt.Errorf("`disk_size_gb` can only be increased - it cannot be decreased from %d to %d", old.(int), newSize)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
 	ctx := meta.(*ArmClient).StopContext
@@ -124,14 +142,7 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	expandedTags := expandTags(tags)
 	zones := expandZones(d.Get("zones").([]interface{}))
 
-	var skuName compute.StorageAccountTypes
-	if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesPremiumLRS)) {
-		skuName = compute.StorageAccountTypesPremiumLRS
-	} else if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesStandardLRS)) {
-		skuName = compute.StorageAccountTypesStandardLRS
-	} else if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesStandardSSDLRS)) {
-		skuName = compute.StorageAccountTypesStandardSSDLRS
-	}
+	skuName := expandManagedDiskStorageAccountType(storageAccountType)
 
 	createDisk := compute.Disk{
 		Name:     &name,
@@ -207,6 +218,152 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceArmManagedDiskRead(d, meta)
 }
 
+func resourceArmManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).diskClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["disks"]
+
+	disk, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(disk.Response) {
+			return fmt.Errorf("Error Managed Disk %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error retrieving Managed Disk %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	diskUpdate := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{},
+		Tags:                 expandTags(tags),
+	}
+
+	// resizing a disk or changing its SKU is only possible when it's not attached to a running Virtual Machine
+	shouldShutDown := false
+
+	if d.HasChange("storage_account_type") {
+		shouldShutDown = true
+		diskUpdate.Sku = &compute.DiskSku{
+			Name: expandManagedDiskStorageAccountType(d.Get("storage_account_type").(string)),
+		}
+	}
+
+	if d.HasChange("disk_size_gb") {
+		shouldShutDown = true
+		diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(d.Get("disk_size_gb").(int)))
+	}
+
+	if d.HasChange("os_type") {
+		diskUpdate.DiskUpdateProperties.OsType = compute.OperatingSystemTypes(d.Get("os_type").(string))
+	}
+
+	if d.HasChange("encryption_settings") {
+		if v, ok := d.GetOk("encryption_settings"); ok {
+			encryptionSettings := v.([]interface{})
+			settings := encryptionSettings[0].(map[string]interface{})
+			diskUpdate.DiskUpdateProperties.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
+		}
+	}
+
+	shouldStart := false
+	var virtualMachine *ResourceID
+	if shouldShutDown && disk.ManagedBy != nil {
+		virtualMachine, err = parseAzureResourceID(*disk.ManagedBy)
+		if err != nil {
+			return fmt.Errorf("Error parsing Virtual Machine ID %q for Managed Disk %q (Resource Group %q): %+v", *disk.ManagedBy, name, resGroup, err)
+		}
+
+		shouldStart, err = resourceArmManagedDiskDeallocateVirtualMachine(meta, virtualMachine)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Managed Disk %q (Resource Group %q)..", name, resGroup)
+	future, err := client.Update(ctx, resGroup, name, diskUpdate)
+	if err != nil {
+		return fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Managed Disk %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Managed Disk %q (Resource Group %q).", name, resGroup)
+
+	if shouldStart {
+		vmClient := meta.(*ArmClient).vmClient
+		vmName := virtualMachine.Path["virtualMachines"]
+
+		log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", vmName, virtualMachine.ResourceGroup)
+		startFuture, err := vmClient.Start(ctx, virtualMachine.ResourceGroup, vmName)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", vmName, virtualMachine.ResourceGroup, err)
+		}
+
+		if err = startFuture.WaitForCompletionRef(ctx, vmClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for start of Virtual Machine %q (Resource Group %q): %+v", vmName, virtualMachine.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q).", vmName, virtualMachine.ResourceGroup)
+	}
+
+	return resourceArmManagedDiskRead(d, meta)
+}
+
+// resourceArmManagedDiskDeallocateVirtualMachine deallocates the Virtual Machine the disk is attached to, returning
+// whether it was running (and as such should be started again once the disk has been updated)
+func resourceArmManagedDiskDeallocateVirtualMachine(meta interface{}, id *ResourceID) (bool, error) {
+	client := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := id.ResourceGroup
+	name := id.Path["virtualMachines"]
+
+	instanceView, err := client.InstanceView(ctx, resGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving Instance View for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	shouldDeallocate := true
+	wasRunning := false
+	if statuses := instanceView.Statuses; statuses != nil {
+		for _, status := range *statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			code := strings.ToLower(*status.Code)
+			if code == "powerstate/deallocated" {
+				shouldDeallocate = false
+			}
+			if code == "powerstate/running" {
+				wasRunning = true
+			}
+		}
+	}
+
+	if !shouldDeallocate {
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q) to update the attached Managed Disk..", name, resGroup)
+	future, err := client.Deallocate(ctx, resGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return false, fmt.Errorf("Error waiting for deallocation of Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q).", name, resGroup)
+
+	return wasRunning, nil
+}
+
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
 	ctx := meta.(*ArmClient).StopContext
@@ -292,6 +449,18 @@ func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func expandManagedDiskStorageAccountType(storageAccountType string) compute.StorageAccountTypes {
+	var skuName compute.StorageAccountTypes
+	if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesPremiumLRS)) {
+		skuName = compute.StorageAccountTypesPremiumLRS
+	} else if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesStandardLRS)) {
+		skuName = compute.StorageAccountTypesStandardLRS
+	} else if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesStandardSSDLRS)) {
+		skuName = compute.StorageAccountTypesStandardSSDLRS
+	}
+	return skuName
+}
+
 func flattenAzureRmManagedDiskCreationData(d *schema.ResourceData, creationData *compute.CreationData) {
 	d.Set("create_option", string(creationData.CreateOption))
 	if ref := creationData.ImageReference; ref != nil {
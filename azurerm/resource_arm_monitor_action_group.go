@@ -6,7 +6,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -104,6 +106,154 @@ func resourceArmMonitorActionGroup() *schema.Resource {
 				},
 			},
 
+			"itsm_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"connection_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"ticket_configuration": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+						"region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+					},
+				},
+			},
+
+			"azure_app_push_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"automation_runbook_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"automation_account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"runbook_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"webhook_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"is_global_runbook": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"service_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+					},
+				},
+			},
+
+			"logic_app_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"callback_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+					},
+				},
+			},
+
+			"azure_function_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"function_app_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"function_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"http_trigger_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.URLIsHTTPOrHTTPS,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -122,6 +272,11 @@ func resourceArmMonitorActionGroupCreateOrUpdate(d *schema.ResourceData, meta in
 	emailReceiversRaw := d.Get("email_receiver").([]interface{})
 	smsReceiversRaw := d.Get("sms_receiver").([]interface{})
 	webhookReceiversRaw := d.Get("webhook_receiver").([]interface{})
+	itsmReceiversRaw := d.Get("itsm_receiver").([]interface{})
+	azureAppPushReceiversRaw := d.Get("azure_app_push_receiver").([]interface{})
+	automationRunbookReceiversRaw := d.Get("automation_runbook_receiver").([]interface{})
+	logicAppReceiversRaw := d.Get("logic_app_receiver").([]interface{})
+	azureFunctionReceiversRaw := d.Get("azure_function_receiver").([]interface{})
 
 	tags := d.Get("tags").(map[string]interface{})
 	expandedTags := expandTags(tags)
@@ -129,11 +284,16 @@ func resourceArmMonitorActionGroupCreateOrUpdate(d *schema.ResourceData, meta in
 	parameters := insights.ActionGroupResource{
 		Location: utils.String(azureRMNormalizeLocation("Global")),
 		ActionGroup: &insights.ActionGroup{
-			GroupShortName:   utils.String(shortName),
-			Enabled:          utils.Bool(enabled),
-			EmailReceivers:   expandMonitorActionGroupEmailReceiver(emailReceiversRaw),
-			SmsReceivers:     expandMonitorActionGroupSmsReceiver(smsReceiversRaw),
-			WebhookReceivers: expandMonitorActionGroupWebHookReceiver(webhookReceiversRaw),
+			GroupShortName:             utils.String(shortName),
+			Enabled:                    utils.Bool(enabled),
+			EmailReceivers:             expandMonitorActionGroupEmailReceiver(emailReceiversRaw),
+			SmsReceivers:               expandMonitorActionGroupSmsReceiver(smsReceiversRaw),
+			WebhookReceivers:           expandMonitorActionGroupWebHookReceiver(webhookReceiversRaw),
+			ItsmReceivers:              expandMonitorActionGroupItsmReceiver(itsmReceiversRaw),
+			AzureAppPushReceivers:      expandMonitorActionGroupAzureAppPushReceiver(azureAppPushReceiversRaw),
+			AutomationRunbookReceivers: expandMonitorActionGroupAutomationRunbookReceiver(automationRunbookReceiversRaw),
+			LogicAppReceivers:          expandMonitorActionGroupLogicAppReceiver(logicAppReceiversRaw),
+			AzureFunctionReceivers:     expandMonitorActionGroupAzureFunctionReceiver(azureFunctionReceiversRaw),
 		},
 		Tags: expandedTags,
 	}
@@ -194,6 +354,26 @@ func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{})
 		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
 			return fmt.Errorf("Error setting `webhook_receiver`: %+v", err)
 		}
+
+		if err = d.Set("itsm_receiver", flattenMonitorActionGroupItsmReceiver(group.ItsmReceivers)); err != nil {
+			return fmt.Errorf("Error setting `itsm_receiver`: %+v", err)
+		}
+
+		if err = d.Set("azure_app_push_receiver", flattenMonitorActionGroupAzureAppPushReceiver(group.AzureAppPushReceivers)); err != nil {
+			return fmt.Errorf("Error setting `azure_app_push_receiver`: %+v", err)
+		}
+
+		if err = d.Set("automation_runbook_receiver", flattenMonitorActionGroupAutomationRunbookReceiver(group.AutomationRunbookReceivers)); err != nil {
+			return fmt.Errorf("Error setting `automation_runbook_receiver`: %+v", err)
+		}
+
+		if err = d.Set("logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(group.LogicAppReceivers)); err != nil {
+			return fmt.Errorf("Error setting `logic_app_receiver`: %+v", err)
+		}
+
+		if err = d.Set("azure_function_receiver", flattenMonitorActionGroupAzureFunctionReceiver(group.AzureFunctionReceivers)); err != nil {
+			return fmt.Errorf("Error setting `azure_function_receiver`: %+v", err)
+		}
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -262,6 +442,81 @@ func expandMonitorActionGroupWebHookReceiver(v []interface{}) *[]insights.Webhoo
 	return &receivers
 }
 
+func expandMonitorActionGroupItsmReceiver(v []interface{}) *[]insights.ItsmReceiver {
+	receivers := make([]insights.ItsmReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := insights.ItsmReceiver{
+			Name:                utils.String(val["name"].(string)),
+			WorkspaceID:         utils.String(val["workspace_id"].(string)),
+			ConnectionID:        utils.String(val["connection_id"].(string)),
+			TicketConfiguration: utils.String(val["ticket_configuration"].(string)),
+			Region:              utils.String(azureRMNormalizeLocation(val["region"].(string))),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupAzureAppPushReceiver(v []interface{}) *[]insights.AzureAppPushReceiver {
+	receivers := make([]insights.AzureAppPushReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := insights.AzureAppPushReceiver{
+			Name:         utils.String(val["name"].(string)),
+			EmailAddress: utils.String(val["email_address"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupAutomationRunbookReceiver(v []interface{}) *[]insights.AutomationRunbookReceiver {
+	receivers := make([]insights.AutomationRunbookReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := insights.AutomationRunbookReceiver{
+			Name:                utils.String(val["name"].(string)),
+			AutomationAccountID: utils.String(val["automation_account_id"].(string)),
+			RunbookName:         utils.String(val["runbook_name"].(string)),
+			WebhookResourceID:   utils.String(val["webhook_resource_id"].(string)),
+			IsGlobalRunbook:     utils.Bool(val["is_global_runbook"].(bool)),
+			ServiceURI:          utils.String(val["service_uri"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupLogicAppReceiver(v []interface{}) *[]insights.LogicAppReceiver {
+	receivers := make([]insights.LogicAppReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := insights.LogicAppReceiver{
+			Name:        utils.String(val["name"].(string)),
+			ResourceID:  utils.String(val["resource_id"].(string)),
+			CallbackURL: utils.String(val["callback_url"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
+func expandMonitorActionGroupAzureFunctionReceiver(v []interface{}) *[]insights.AzureFunctionReceiver {
+	receivers := make([]insights.AzureFunctionReceiver, 0)
+	for _, receiverValue := range v {
+		val := receiverValue.(map[string]interface{})
+		receiver := insights.AzureFunctionReceiver{
+			Name:                  utils.String(val["name"].(string)),
+			FunctionAppResourceID: utils.String(val["function_app_resource_id"].(string)),
+			FunctionName:          utils.String(val["function_name"].(string)),
+			HTTPTriggerURL:        utils.String(val["http_trigger_url"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+	return &receivers
+}
+
 func flattenMonitorActionGroupEmailReceiver(receivers *[]insights.EmailReceiver) []interface{} {
 	result := make([]interface{}, 0)
 	if receivers != nil {
@@ -315,3 +570,118 @@ func flattenMonitorActionGroupWebHookReceiver(receivers *[]insights.WebhookRecei
 	}
 	return result
 }
+
+func flattenMonitorActionGroupItsmReceiver(receivers *[]insights.ItsmReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.WorkspaceID != nil {
+				val["workspace_id"] = *receiver.WorkspaceID
+			}
+			if receiver.ConnectionID != nil {
+				val["connection_id"] = *receiver.ConnectionID
+			}
+			if receiver.TicketConfiguration != nil {
+				val["ticket_configuration"] = *receiver.TicketConfiguration
+			}
+			if receiver.Region != nil {
+				val["region"] = azureRMNormalizeLocation(*receiver.Region)
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupAzureAppPushReceiver(receivers *[]insights.AzureAppPushReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.EmailAddress != nil {
+				val["email_address"] = *receiver.EmailAddress
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupAutomationRunbookReceiver(receivers *[]insights.AutomationRunbookReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.AutomationAccountID != nil {
+				val["automation_account_id"] = *receiver.AutomationAccountID
+			}
+			if receiver.RunbookName != nil {
+				val["runbook_name"] = *receiver.RunbookName
+			}
+			if receiver.WebhookResourceID != nil {
+				val["webhook_resource_id"] = *receiver.WebhookResourceID
+			}
+			if receiver.IsGlobalRunbook != nil {
+				val["is_global_runbook"] = *receiver.IsGlobalRunbook
+			}
+			if receiver.ServiceURI != nil {
+				val["service_uri"] = *receiver.ServiceURI
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupLogicAppReceiver(receivers *[]insights.LogicAppReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.ResourceID != nil {
+				val["resource_id"] = *receiver.ResourceID
+			}
+			if receiver.CallbackURL != nil {
+				val["callback_url"] = *receiver.CallbackURL
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+func flattenMonitorActionGroupAzureFunctionReceiver(receivers *[]insights.AzureFunctionReceiver) []interface{} {
+	result := make([]interface{}, 0)
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.FunctionAppResourceID != nil {
+				val["function_app_resource_id"] = *receiver.FunctionAppResourceID
+			}
+			if receiver.FunctionName != nil {
+				val["function_name"] = *receiver.FunctionName
+			}
+			if receiver.HTTPTriggerURL != nil {
+				val["http_trigger_url"] = *receiver.HTTPTriggerURL
+			}
+			result = append(result, val)
+		}
+	}
+	return result
+}
@@ -130,6 +130,145 @@ func TestAccAzureRMMonitorActionGroup_webhookReceiver(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMonitorActionGroup_itsmReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorActionGroup_itsmReceiver(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "itsm_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "itsm_receiver.0.workspace_id", "6eee3a18-aac3-40e4-b98e-1f309f329816|55dfd1f8-7e59-4f89-bf56-4c82f5ace23c"),
+					resource.TestCheckResourceAttr(resourceName, "itsm_receiver.0.connection_id", "53de6956-42b4-41ba-be3c-b154cdf17b13"),
+					resource.TestCheckResourceAttr(resourceName, "itsm_receiver.0.region", "southcentralus"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_azureAppPushReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorActionGroup_azureAppPushReceiver(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "azure_app_push_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "azure_app_push_receiver.0.email_address", "admin@contoso.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_automationRunbookReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorActionGroup_automationRunbookReceiver(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "automation_runbook_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "automation_runbook_receiver.0.runbook_name", "my runbook"),
+					resource.TestCheckResourceAttr(resourceName, "automation_runbook_receiver.0.is_global_runbook", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_logicAppReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorActionGroup_logicAppReceiver(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logic_app_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logic_app_receiver.0.callback_url", "http://test-host:100/workflows/fb9c8d79b15f41ce9b12861862f43546/versions/08587100027316071865/triggers/manualTrigger/paths/invoke?api-version=2015-08-01-preview&sp=%2Fversions%2F08587100027316071865%2Ftriggers%2FmanualTrigger%2Frun&sv=1.0&sig=IxEQ_ygZf6WNEQCbjV0Vs6p6Y4DyNEJVAa86U5B4xhk"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActionGroup_azureFunctionReceiver(t *testing.T) {
+	resourceName := "azurerm_monitor_action_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorActionGroup_azureFunctionReceiver(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActionGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "azure_function_receiver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "azure_function_receiver.0.function_name", "myfunc"),
+					resource.TestCheckResourceAttr(resourceName, "azure_function_receiver.0.http_trigger_url", "https://example.com/trigger"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMonitorActionGroup_complete(t *testing.T) {
 	resourceName := "azurerm_monitor_action_group.test"
 	ri := acctest.RandInt()
@@ -385,6 +524,116 @@ resource "azurerm_monitor_action_group" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMMonitorActionGroup_itsmReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  itsm_receiver {
+    name                 = "createorupdateticket"
+    workspace_id         = "6eee3a18-aac3-40e4-b98e-1f309f329816|55dfd1f8-7e59-4f89-bf56-4c82f5ace23c"
+    connection_id        = "53de6956-42b4-41ba-be3c-b154cdf17b13"
+    ticket_configuration = "{}"
+    region               = "southcentralus"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_azureAppPushReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  azure_app_push_receiver {
+    name          = "pushtoadmin"
+    email_address = "admin@contoso.com"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_automationRunbookReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  automation_runbook_receiver {
+    name                  = "action_name_1"
+    automation_account_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-runbooks/providers/microsoft.automation/automationaccounts/aaa001"
+    runbook_name          = "my runbook"
+    webhook_resource_id   = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-runbooks/providers/microsoft.automation/automationaccounts/aaa001/webhooks/webhook_alert"
+    is_global_runbook     = true
+    service_uri           = "https://s13events.azure-automation.net/webhooks?token=randomtoken"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_logicAppReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  logic_app_receiver {
+    name         = "logicappaction"
+    resource_id  = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-logicapp/providers/Microsoft.Logic/workflows/logicapp"
+    callback_url = "http://test-host:100/workflows/fb9c8d79b15f41ce9b12861862f43546/versions/08587100027316071865/triggers/manualTrigger/paths/invoke?api-version=2015-08-01-preview&sp=%%2Fversions%%2F08587100027316071865%%2Ftriggers%%2FmanualTrigger%%2Frun&sv=1.0&sig=IxEQ_ygZf6WNEQCbjV0Vs6p6Y4DyNEJVAa86U5B4xhk"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActionGroup_azureFunctionReceiver(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+
+  azure_function_receiver {
+    name                     = "funcaction"
+    function_app_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-funcapp/providers/Microsoft.Web/sites/funcapp"
+    function_name            = "myfunc"
+    http_trigger_url         = "https://example.com/trigger"
+  }
+}
+`, rInt, location, rInt)
+}
+
 func testAccAzureRMMonitorActionGroup_complete(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
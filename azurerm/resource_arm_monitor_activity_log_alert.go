@@ -0,0 +1,399 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorActivityLogAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorActivityLogAlertCreateOrUpdate,
+		Read:   resourceArmMonitorActivityLogAlertRead,
+		Update: resourceArmMonitorActivityLogAlertCreateOrUpdate,
+		Delete: resourceArmMonitorActivityLogAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Administrative",
+								"Autoscale",
+								"Policy",
+								"Recommendation",
+								"Security",
+								"ServiceHealth",
+							}, false),
+						},
+
+						"operation_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"caller": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Verbose",
+								"Informational",
+								"Warning",
+								"Error",
+								"Critical",
+							}, false),
+						},
+
+						"resource_provider": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"resource_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"resource_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"resource_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"sub_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"webhook_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// monitorActivityLogAlertCriteriaFields maps the fields within the `criteria` block to the
+// field names used for each leaf condition in the API
+var monitorActivityLogAlertCriteriaFields = []struct {
+	Key   string
+	Field string
+}{
+	{Key: "category", Field: "category"},
+	{Key: "operation_name", Field: "operationName"},
+	{Key: "caller", Field: "caller"},
+	{Key: "level", Field: "level"},
+	{Key: "resource_provider", Field: "resourceProvider"},
+	{Key: "resource_type", Field: "resourceType"},
+	{Key: "resource_group", Field: "resourceGroup"},
+	{Key: "resource_id", Field: "resourceId"},
+	{Key: "status", Field: "status"},
+	{Key: "sub_status", Field: "subStatus"},
+}
+
+func resourceArmMonitorActivityLogAlertCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActivityLogAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Activity Log Alert creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	scopes := make([]string, 0)
+	for _, scope := range d.Get("scopes").(*schema.Set).List() {
+		scopes = append(scopes, scope.(string))
+	}
+
+	parameters := insights.ActivityLogAlertResource{
+		// Activity Log Alerts are a global resource
+		Location: utils.String("Global"),
+		ActivityLogAlert: &insights.ActivityLogAlert{
+			Scopes:      &scopes,
+			Enabled:     utils.Bool(d.Get("enabled").(bool)),
+			Description: utils.String(d.Get("description").(string)),
+			Condition:   expandMonitorActivityLogAlertCriteria(d.Get("criteria").([]interface{})),
+			Actions:     expandMonitorActivityLogAlertActions(d.Get("action").(*schema.Set).List()),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating or updating Activity Log Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Activity Log Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Activity Log Alert %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorActivityLogAlertRead(d, meta)
+}
+
+func resourceArmMonitorActivityLogAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActivityLogAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["activityLogAlerts"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Activity Log Alert %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Activity Log Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if alert := resp.ActivityLogAlert; alert != nil {
+		d.Set("enabled", alert.Enabled)
+		d.Set("description", alert.Description)
+
+		scopes := make([]interface{}, 0)
+		if alert.Scopes != nil {
+			for _, scope := range *alert.Scopes {
+				scopes = append(scopes, scope)
+			}
+		}
+		if err := d.Set("scopes", scopes); err != nil {
+			return fmt.Errorf("Error setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("criteria", flattenMonitorActivityLogAlertCriteria(alert.Condition)); err != nil {
+			return fmt.Errorf("Error setting `criteria`: %+v", err)
+		}
+
+		if err := d.Set("action", flattenMonitorActivityLogAlertActions(alert.Actions)); err != nil {
+			return fmt.Errorf("Error setting `action`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorActivityLogAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActivityLogAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["activityLogAlerts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Activity Log Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.ActivityLogAlertAllOfCondition {
+	conditions := make([]insights.ActivityLogAlertLeafCondition, 0)
+
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+
+		for _, criterion := range monitorActivityLogAlertCriteriaFields {
+			value, ok := v[criterion.Key].(string)
+			if !ok || value == "" {
+				continue
+			}
+
+			conditions = append(conditions, insights.ActivityLogAlertLeafCondition{
+				Field:  utils.String(criterion.Field),
+				Equals: utils.String(value),
+			})
+		}
+	}
+
+	return &insights.ActivityLogAlertAllOfCondition{
+		AllOf: &conditions,
+	}
+}
+
+func expandMonitorActivityLogAlertActions(input []interface{}) *insights.ActivityLogAlertActionList {
+	actionGroups := make([]insights.ActivityLogAlertActionGroup, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		properties := make(map[string]*string)
+		for key, value := range v["webhook_properties"].(map[string]interface{}) {
+			properties[key] = utils.String(value.(string))
+		}
+
+		actionGroups = append(actionGroups, insights.ActivityLogAlertActionGroup{
+			ActionGroupID:     utils.String(v["action_group_id"].(string)),
+			WebhookProperties: properties,
+		})
+	}
+
+	return &insights.ActivityLogAlertActionList{
+		ActionGroups: &actionGroups,
+	}
+}
+
+func flattenMonitorActivityLogAlertCriteria(input *insights.ActivityLogAlertAllOfCondition) []interface{} {
+	if input == nil || input.AllOf == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	for _, condition := range *input.AllOf {
+		if condition.Field == nil || condition.Equals == nil {
+			continue
+		}
+
+		// the API treats the field names as case-insensitive
+		for _, criterion := range monitorActivityLogAlertCriteriaFields {
+			if strings.EqualFold(criterion.Field, *condition.Field) {
+				output[criterion.Key] = *condition.Equals
+				break
+			}
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenMonitorActivityLogAlertActions(input *insights.ActivityLogAlertActionList) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.ActionGroups == nil {
+		return results
+	}
+
+	for _, action := range *input.ActionGroups {
+		output := make(map[string]interface{})
+
+		if v := action.ActionGroupID; v != nil {
+			output["action_group_id"] = *v
+		}
+
+		properties := make(map[string]interface{})
+		for key, value := range action.WebhookProperties {
+			if value != nil {
+				properties[key] = *value
+			}
+		}
+		output["webhook_properties"] = properties
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorActivityLogAlert_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_activity_log_alert.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorActivityLogAlert_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActivityLogAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.category", "Administrative"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.operation_name", "Microsoft.Resources/subscriptions/resourceGroups/delete"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActivityLogAlert_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_activity_log_alert.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMMonitorActivityLogAlert_complete(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActivityLogAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is just a test resource."),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.operation_name", "Microsoft.Storage/storageAccounts/write"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.category", "Recommendation"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_provider", "Microsoft.Storage"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_type", "Microsoft.Storage/storageAccounts"),
+					resource.TestCheckResourceAttrSet(resourceName, "criteria.0.resource_group"),
+					resource.TestCheckResourceAttrSet(resourceName, "criteria.0.resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.level", "Critical"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.status", "Succeeded"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorActivityLogAlert_basicAndCompleteUpdate(t *testing.T) {
+	resourceName := "azurerm_monitor_activity_log_alert.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorActivityLogAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorActivityLogAlert_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorActivityLogAlert_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorActivityLogAlert_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorActivityLogAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorActivityLogAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Activity Log Alert: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorActivityLogAlertsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Activity Log Alert %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on monitorActivityLogAlertsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorActivityLogAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorActivityLogAlertsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_activity_log_alert" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Activity Log Alert %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorActivityLogAlert_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_resource_group.test.id}"]
+
+  criteria {
+    category       = "Administrative"
+    operation_name = "Microsoft.Resources/subscriptions/resourceGroups/delete"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorActivityLogAlert_complete(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test1" {
+  name                = "acctestActionGroup1-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag1"
+}
+
+resource "azurerm_monitor_action_group" "test2" {
+  name                = "acctestActionGroup2-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag2"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  enabled             = false
+  description         = "This is just a test resource."
+
+  scopes = [
+    "${azurerm_resource_group.test.id}",
+    "${azurerm_storage_account.test.id}",
+  ]
+
+  criteria {
+    operation_name    = "Microsoft.Storage/storageAccounts/write"
+    category          = "Recommendation"
+    resource_provider = "Microsoft.Storage"
+    resource_type     = "Microsoft.Storage/storageAccounts"
+    resource_group    = "${azurerm_resource_group.test.name}"
+    resource_id       = "${azurerm_storage_account.test.id}"
+    level             = "Critical"
+    status            = "Succeeded"
+  }
+
+  action {
+    action_group_id = "${azurerm_monitor_action_group.test1.id}"
+  }
+
+  action {
+    action_group_id = "${azurerm_monitor_action_group.test2.id}"
+
+    webhook_properties {
+      from = "terraform test"
+      to   = "microsoft azure"
+    }
+  }
+}
+`, rInt, location, rInt, rInt, rString, rInt)
+}
@@ -0,0 +1,470 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorDiagnosticSetting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorDiagnosticSettingCreateOrUpdate,
+		Read:   resourceArmMonitorDiagnosticSettingRead,
+		Update: resourceArmMonitorDiagnosticSettingCreateOrUpdate,
+		Delete: resourceArmMonitorDiagnosticSettingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmMonitorDiagnosticSettingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"target_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"eventhub_authorization_rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"eventhub_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"log": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceArmMonitorDiagnosticSettingCategoryHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"retention_policy": monitorDiagnosticSettingRetentionPolicySchema(),
+					},
+				},
+			},
+
+			"metric": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceArmMonitorDiagnosticSettingCategoryHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"retention_policy": monitorDiagnosticSettingRetentionPolicySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func monitorDiagnosticSettingRetentionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 365),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmMonitorDiagnosticSettingCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// values which aren't known at plan time (e.g. the ID of a Storage Account created in the same run) will be set
+	hasDestination := false
+	for _, key := range []string{"storage_account_id", "eventhub_authorization_rule_id", "log_analytics_workspace_id"} {
+		if !diff.NewValueKnown(key) || diff.Get(key).(string) != "" {
+			hasDestination = true
+		}
+	}
+	if !hasDestination {
+		return fmt.Errorf("At least one of `storage_account_id`, `eventhub_authorization_rule_id` or `log_analytics_workspace_id` must be specified")
+	}
+
+	hasCategory := false
+	for _, key := range []string{"log", "metric"} {
+		if !diff.NewValueKnown(key) || diff.Get(key).(*schema.Set).Len() > 0 {
+			hasCategory = true
+		}
+	}
+	if !hasCategory {
+		return fmt.Errorf("At least one `log` or `metric` block must be specified")
+	}
+
+	return nil
+}
+
+func resourceArmMonitorDiagnosticSettingCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
+	eventHubName := d.Get("eventhub_name").(string)
+	workspaceId := d.Get("log_analytics_workspace_id").(string)
+
+	logs := d.Get("log").(*schema.Set).List()
+	metrics := d.Get("metric").(*schema.Set).List()
+
+	properties := insights.DiagnosticSettings{
+		Logs:    expandMonitorDiagnosticSettingLogs(logs),
+		Metrics: expandMonitorDiagnosticSettingMetrics(metrics),
+	}
+
+	if storageAccountId != "" {
+		properties.StorageAccountID = utils.String(storageAccountId)
+	}
+
+	if eventHubAuthorizationRuleId != "" {
+		properties.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
+	}
+
+	if eventHubName != "" {
+		properties.EventHubName = utils.String(eventHubName)
+	}
+
+	if workspaceId != "" {
+		properties.WorkspaceID = utils.String(workspaceId)
+	}
+
+	parameters := insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &properties,
+	}
+
+	resourceUri := strings.TrimPrefix(targetResourceId, "/")
+	if _, err := client.CreateOrUpdate(ctx, resourceUri, parameters, name); err != nil {
+		return fmt.Errorf("Error creating or updating Diagnostic Setting %q (Resource %q): %+v", name, targetResourceId, err)
+	}
+
+	read, err := client.Get(ctx, resourceUri, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Diagnostic Setting %q (Resource %q): %+v", name, targetResourceId, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Diagnostic Setting %q (Resource %q) ID", name, targetResourceId)
+	}
+
+	// the ID returned from the API has the Target Resource ID lower-cased, so we build our own to retain its casing
+	d.SetId(fmt.Sprintf("%s%s%s", targetResourceId, monitorDiagnosticSettingIdSeparator, name))
+
+	return resourceArmMonitorDiagnosticSettingRead(d, meta)
+}
+
+func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseMonitorDiagnosticSettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, strings.TrimPrefix(id.TargetResourceId, "/"), id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Diagnostic Setting %q (Resource %q): %+v", id.Name, id.TargetResourceId, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("target_resource_id", id.TargetResourceId)
+
+	if props := resp.DiagnosticSettings; props != nil {
+		d.Set("storage_account_id", props.StorageAccountID)
+		d.Set("eventhub_authorization_rule_id", props.EventHubAuthorizationRuleID)
+		d.Set("eventhub_name", props.EventHubName)
+		d.Set("log_analytics_workspace_id", props.WorkspaceID)
+
+		if err := d.Set("log", flattenMonitorDiagnosticSettingLogs(d, props.Logs)); err != nil {
+			return fmt.Errorf("Error setting `log`: %+v", err)
+		}
+
+		if err := d.Set("metric", flattenMonitorDiagnosticSettingMetrics(d, props.Metrics)); err != nil {
+			return fmt.Errorf("Error setting `metric`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseMonitorDiagnosticSettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, strings.TrimPrefix(id.TargetResourceId, "/"), id.Name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Diagnostic Setting %q (Resource %q): %+v", id.Name, id.TargetResourceId, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorDiagnosticSettingLogs(input []interface{}) *[]insights.LogSettings {
+	results := make([]insights.LogSettings, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, insights.LogSettings{
+			Category:        utils.String(v["category"].(string)),
+			Enabled:         utils.Bool(v["enabled"].(bool)),
+			RetentionPolicy: expandMonitorDiagnosticSettingRetentionPolicy(v["retention_policy"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandMonitorDiagnosticSettingMetrics(input []interface{}) *[]insights.MetricSettings {
+	results := make([]insights.MetricSettings, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, insights.MetricSettings{
+			Category:        utils.String(v["category"].(string)),
+			Enabled:         utils.Bool(v["enabled"].(bool)),
+			RetentionPolicy: expandMonitorDiagnosticSettingRetentionPolicy(v["retention_policy"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandMonitorDiagnosticSettingRetentionPolicy(input []interface{}) *insights.RetentionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return &insights.RetentionPolicy{
+			Enabled: utils.Bool(false),
+			Days:    utils.Int32(0),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &insights.RetentionPolicy{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+// the API returns every category supported by the Target Resource, so only those which are
+// either enabled or defined in the configuration are flattened, to avoid a perpetual diff
+func monitorDiagnosticSettingConfiguredCategories(d *schema.ResourceData, key string) map[string]map[string]interface{} {
+	categories := make(map[string]map[string]interface{})
+
+	for _, raw := range d.Get(key).(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		categories[strings.ToLower(v["category"].(string))] = v
+	}
+
+	return categories
+}
+
+// monitorDiagnosticSettingHasRetentionPolicy returns whether a `retention_policy` block is defined for the configured category
+func monitorDiagnosticSettingHasRetentionPolicy(category map[string]interface{}) bool {
+	if category == nil {
+		return false
+	}
+
+	policies, ok := category["retention_policy"].([]interface{})
+	return ok && len(policies) > 0 && policies[0] != nil
+}
+
+func flattenMonitorDiagnosticSettingLogs(d *schema.ResourceData, input *[]insights.LogSettings) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	configured := monitorDiagnosticSettingConfiguredCategories(d, "log")
+	for _, log := range *input {
+		if log.Category == nil {
+			continue
+		}
+
+		enabled := log.Enabled != nil && *log.Enabled
+		category, isConfigured := configured[strings.ToLower(*log.Category)]
+		if !enabled && !isConfigured {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"category":         *log.Category,
+			"enabled":          enabled,
+			"retention_policy": flattenMonitorDiagnosticSettingRetentionPolicy(log.RetentionPolicy, monitorDiagnosticSettingHasRetentionPolicy(category)),
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorDiagnosticSettingMetrics(d *schema.ResourceData, input *[]insights.MetricSettings) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	configured := monitorDiagnosticSettingConfiguredCategories(d, "metric")
+	for _, metric := range *input {
+		if metric.Category == nil {
+			continue
+		}
+
+		enabled := metric.Enabled != nil && *metric.Enabled
+		category, isConfigured := configured[strings.ToLower(*metric.Category)]
+		if !enabled && !isConfigured {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"category":         *metric.Category,
+			"enabled":          enabled,
+			"retention_policy": flattenMonitorDiagnosticSettingRetentionPolicy(metric.RetentionPolicy, monitorDiagnosticSettingHasRetentionPolicy(category)),
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorDiagnosticSettingRetentionPolicy(input *insights.RetentionPolicy, isConfigured bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// a disabled retention policy is the same as omitting the block, so it's only flattened when it's configured
+	enabled := input.Enabled != nil && *input.Enabled
+	if !enabled && !isConfigured {
+		return []interface{}{}
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"days":    days,
+		},
+	}
+}
+
+func resourceArmMonitorDiagnosticSettingCategoryHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["category"].(string))))
+		buf.WriteString(fmt.Sprintf("%t-", m["enabled"].(bool)))
+
+		if policies, ok := m["retention_policy"].([]interface{}); ok && len(policies) > 0 && policies[0] != nil {
+			policy := policies[0].(map[string]interface{})
+			buf.WriteString(fmt.Sprintf("%t-%d", policy["enabled"].(bool), policy["days"].(int)))
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
+
+const monitorDiagnosticSettingIdSeparator = "/providers/Microsoft.Insights/diagnosticSettings/"
+
+type monitorDiagnosticSettingId struct {
+	TargetResourceId string
+	Name             string
+}
+
+func parseMonitorDiagnosticSettingId(id string) (*monitorDiagnosticSettingId, error) {
+	// the casing of the Resource Provider varies between the API and the Portal
+	index := strings.LastIndex(strings.ToLower(id), strings.ToLower(monitorDiagnosticSettingIdSeparator))
+	if index <= 0 {
+		return nil, fmt.Errorf("Expected ID to be in the format `{targetResourceId}/providers/Microsoft.Insights/diagnosticSettings/{name}` - got %q", id)
+	}
+
+	segments := []string{id[:index], id[index+len(monitorDiagnosticSettingIdSeparator):]}
+	if segments[1] == "" || strings.Contains(segments[1], "/") {
+		return nil, fmt.Errorf("Expected ID to be in the format `{targetResourceId}/providers/Microsoft.Insights/diagnosticSettings/{name}` - got %q", id)
+	}
+
+	return &monitorDiagnosticSettingId{
+		TargetResourceId: segments[0],
+		Name:             segments[1],
+	}, nil
+}
@@ -0,0 +1,279 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseMonitorDiagnosticSettingId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *monitorDiagnosticSettingId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/diagnosticSettings/",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: &monitorDiagnosticSettingId{
+				TargetResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+				Name:             "setting1",
+			},
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/microsoft.insights/diagnosticSettings/setting1",
+			Expected: &monitorDiagnosticSettingId{
+				TargetResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+				Name:             "setting1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseMonitorDiagnosticSettingId(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.TargetResourceId != v.Expected.TargetResourceId {
+			t.Fatalf("Expected Target Resource ID to be %q but got %q", v.Expected.TargetResourceId, actual.TargetResourceId)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected Name to be %q but got %q", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestAccAzureRMMonitorDiagnosticSetting_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_diagnostic_setting.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMMonitorDiagnosticSetting_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorDiagnosticSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorDiagnosticSettingExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "target_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorDiagnosticSetting_update(t *testing.T) {
+	resourceName := "azurerm_monitor_diagnostic_setting.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorDiagnosticSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorDiagnosticSetting_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorDiagnosticSettingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_analytics_workspace_id", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorDiagnosticSetting_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorDiagnosticSettingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_analytics_workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorDiagnosticSettingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		targetResourceId := rs.Primary.Attributes["target_resource_id"]
+
+		client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, strings.TrimPrefix(targetResourceId, "/"), name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Diagnostic Setting %q (Resource %q) does not exist", name, targetResourceId)
+			}
+			return fmt.Errorf("Bad: Get on monitorDiagnosticSettingsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorDiagnosticSettingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_diagnostic_setting" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		targetResourceId := rs.Primary.Attributes["target_resource_id"]
+
+		resp, err := client.Get(ctx, strings.TrimPrefix(targetResourceId, "/"), name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Diagnostic Setting %q (Resource %q) still exists", name, targetResourceId)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorDiagnosticSetting_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rInt, rString)
+}
+
+func testAccAzureRMMonitorDiagnosticSetting_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorDiagnosticSetting_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name               = "acctestds%d"
+  target_resource_id = "${azurerm_key_vault.test.id}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  log {
+    category = "AuditEvent"
+  }
+
+  metric {
+    category = "AllMetrics"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorDiagnosticSetting_complete(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorDiagnosticSetting_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctestds%d"
+  target_resource_id         = "${azurerm_key_vault.test.id}"
+  storage_account_id         = "${azurerm_storage_account.test.id}"
+  log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+
+  log {
+    category = "AuditEvent"
+
+    retention_policy {
+      enabled = true
+      days    = 30
+    }
+  }
+
+  metric {
+    category = "AllMetrics"
+    enabled  = false
+
+    retention_policy {
+      enabled = false
+    }
+  }
+}
+`, template, rInt, rInt)
+}
@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorLogProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorLogProfileCreateOrUpdate,
+		Read:   resourceArmMonitorLogProfileRead,
+		Update: resourceArmMonitorLogProfileCreateOrUpdate,
+		Delete: resourceArmMonitorLogProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"servicebus_rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"locations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: resourceArmMonitorLogProfileLocationHash,
+			},
+
+			"categories": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Action",
+						"Delete",
+						"Write",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 365),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmMonitorLogProfileCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorLogProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Log Profile creation.")
+
+	name := d.Get("name").(string)
+	storageAccountId := d.Get("storage_account_id").(string)
+	serviceBusRuleId := d.Get("servicebus_rule_id").(string)
+
+	if storageAccountId == "" && serviceBusRuleId == "" {
+		return fmt.Errorf("At least one of `storage_account_id` or `servicebus_rule_id` must be specified")
+	}
+
+	locations := make([]string, 0)
+	for _, location := range d.Get("locations").(*schema.Set).List() {
+		locations = append(locations, azureRMNormalizeLocation(location.(string)))
+	}
+
+	categories := make([]string, 0)
+	for _, category := range d.Get("categories").(*schema.Set).List() {
+		categories = append(categories, category.(string))
+	}
+
+	properties := insights.LogProfileProperties{
+		Locations:       &locations,
+		Categories:      &categories,
+		RetentionPolicy: expandMonitorLogProfileRetentionPolicy(d.Get("retention_policy").([]interface{})),
+	}
+
+	if storageAccountId != "" {
+		properties.StorageAccountID = utils.String(storageAccountId)
+	}
+
+	if serviceBusRuleId != "" {
+		properties.ServiceBusRuleID = utils.String(serviceBusRuleId)
+	}
+
+	parameters := insights.LogProfileResource{
+		Name:                 utils.String(name),
+		LogProfileProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
+		return fmt.Errorf("Error creating or updating Log Profile %q: %+v", name, err)
+	}
+
+	read, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Profile %q: %+v", name, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Log Profile %q ID", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorLogProfileRead(d, meta)
+}
+
+func resourceArmMonitorLogProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorLogProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, err := parseMonitorLogProfileName(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Log Profile %q was not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Log Profile %q: %+v", name, err)
+	}
+
+	d.Set("name", name)
+
+	if props := resp.LogProfileProperties; props != nil {
+		d.Set("storage_account_id", props.StorageAccountID)
+		d.Set("servicebus_rule_id", props.ServiceBusRuleID)
+
+		locations := make([]interface{}, 0)
+		if props.Locations != nil {
+			for _, location := range *props.Locations {
+				locations = append(locations, azureRMNormalizeLocation(location))
+			}
+		}
+		if err := d.Set("locations", locations); err != nil {
+			return fmt.Errorf("Error setting `locations`: %+v", err)
+		}
+
+		categories := make([]interface{}, 0)
+		if props.Categories != nil {
+			for _, category := range *props.Categories {
+				categories = append(categories, category)
+			}
+		}
+		if err := d.Set("categories", categories); err != nil {
+			return fmt.Errorf("Error setting `categories`: %+v", err)
+		}
+
+		if err := d.Set("retention_policy", flattenMonitorLogProfileRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMonitorLogProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorLogProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name, err := parseMonitorLogProfileName(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Log Profile %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorLogProfileRetentionPolicy(input []interface{}) *insights.RetentionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &insights.RetentionPolicy{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenMonitorLogProfileRetentionPolicy(input *insights.RetentionPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if v := input.Enabled; v != nil {
+		output["enabled"] = *v
+	}
+
+	if v := input.Days; v != nil {
+		output["days"] = int(*v)
+	}
+
+	return []interface{}{output}
+}
+
+// locations are hashed in their normalized form, since the API returns them this way
+func resourceArmMonitorLogProfileLocationHash(v interface{}) int {
+	return hashcode.String(azureRMNormalizeLocation(v))
+}
+
+// Log Profiles are scoped to a Subscription rather than a Resource Group, so the ID is in the format
+// `/subscriptions/{subscriptionId}/providers/microsoft.insights/logprofiles/{name}`
+func parseMonitorLogProfileName(id string) (string, error) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) != 6 || !strings.EqualFold(segments[4], "logprofiles") || segments[5] == "" {
+		return "", fmt.Errorf("Expected ID to be in the format `/subscriptions/{subscriptionId}/providers/microsoft.insights/logprofiles/{name}` - got %q", id)
+	}
+
+	return segments[5], nil
+}
@@ -0,0 +1,246 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseMonitorLogProfileName(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/microsoft.insights/logprofiles",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/microsoft.insights/actionGroups/group1",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/microsoft.insights/logprofiles/default",
+			Expected: "default",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Insights/logProfiles/default",
+			Expected: "default",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseMonitorLogProfileName(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+// NOTE: a Subscription can only contain a single Log Profile, so these tests can't be run in parallel
+// and will fail if a Log Profile already exists within the Subscription
+
+func TestAccAzureRMMonitorLogProfile_storageAccount(t *testing.T) {
+	resourceName := "azurerm_monitor_log_profile.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMMonitorLogProfile_storageAccount(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorLogProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorLogProfileExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "categories.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorLogProfile_eventHub(t *testing.T) {
+	resourceName := "azurerm_monitor_log_profile.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorLogProfile_eventHub(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorLogProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorLogProfileExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "servicebus_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "categories.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorLogProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).monitorLogProfilesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Log Profile %q does not exist", name)
+			}
+			return fmt.Errorf("Bad: Get on monitorLogProfilesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorLogProfileDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorLogProfilesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_log_profile" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Log Profile %q still exists", name)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorLogProfile_storageAccount(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "GRS"
+}
+
+resource "azurerm_monitor_log_profile" "test" {
+  name               = "acctestlp-%d"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  categories = [
+    "Action",
+    "Delete",
+    "Write",
+  ]
+
+  locations = [
+    "${azurerm_resource_group.test.location}",
+    "global",
+  ]
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMMonitorLogProfile_eventHub(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctestehns-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  capacity            = 2
+}
+
+resource "azurerm_monitor_log_profile" "test" {
+  name               = "acctestlp-%d"
+  servicebus_rule_id = "${azurerm_eventhub_namespace.test.id}/authorizationrules/RootManageSharedAccessKey"
+
+  categories = [
+    "Delete",
+  ]
+
+  locations = [
+    "${azurerm_resource_group.test.location}",
+  ]
+
+  retention_policy {
+    enabled = false
+  }
+}
+`, rInt, location, rInt, rInt)
+}
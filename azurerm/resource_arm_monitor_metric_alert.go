@@ -0,0 +1,489 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorMetricAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorMetricAlertCreateOrUpdate,
+		Read:   resourceArmMonitorMetricAlertRead,
+		Update: resourceArmMonitorMetricAlertCreateOrUpdate,
+		Delete: resourceArmMonitorMetricAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			// the API only supports a single Scope when using the `SingleResourceMultipleMetricCriteria` criteria
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"aggregation": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Average",
+								"Count",
+								"Minimum",
+								"Maximum",
+								"Total",
+							}, false),
+						},
+
+						"operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Equals",
+								"NotEquals",
+								"GreaterThan",
+								"GreaterThanOrEqual",
+								"LessThan",
+								"LessThanOrEqual",
+							}, false),
+						},
+
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"dimension": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "Include",
+										ValidateFunc: validation.StringInSlice([]string{
+											"Include",
+										}, false),
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"webhook_properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"auto_mitigate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PT1M",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+				}, false),
+			},
+
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"window_size": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "PT5M",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT1M",
+					"PT5M",
+					"PT15M",
+					"PT30M",
+					"PT1H",
+					"PT6H",
+					"PT12H",
+					"P1D",
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorMetricAlertCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Metric Alert creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := insights.MetricAlertResource{
+		// Metric Alerts are a global resource
+		Location: utils.String("global"),
+		MetricAlertProperties: &insights.MetricAlertProperties{
+			Enabled:             utils.Bool(d.Get("enabled").(bool)),
+			AutoMitigate:        utils.Bool(d.Get("auto_mitigate").(bool)),
+			Description:         utils.String(d.Get("description").(string)),
+			Severity:            utils.Int32(int32(d.Get("severity").(int))),
+			EvaluationFrequency: utils.String(d.Get("frequency").(string)),
+			WindowSize:          utils.String(d.Get("window_size").(string)),
+			Scopes:              expandMonitorMetricAlertStringArray(d.Get("scopes").(*schema.Set).List()),
+			Criteria:            expandMonitorMetricAlertCriteria(d.Get("criteria").([]interface{})),
+			Actions:             expandMonitorMetricAlertActions(d.Get("action").(*schema.Set).List()),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating or updating Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Metric Alert %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorMetricAlertRead(d, meta)
+}
+
+func resourceArmMonitorMetricAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["metricAlerts"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Metric Alert %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.MetricAlertProperties; props != nil {
+		d.Set("auto_mitigate", props.AutoMitigate)
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled)
+		d.Set("frequency", props.EvaluationFrequency)
+		d.Set("window_size", props.WindowSize)
+
+		if v := props.Severity; v != nil {
+			d.Set("severity", int(*v))
+		}
+
+		if err := d.Set("scopes", flattenMonitorMetricAlertStringArray(props.Scopes)); err != nil {
+			return fmt.Errorf("Error setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("criteria", flattenMonitorMetricAlertCriteria(props.Criteria)); err != nil {
+			return fmt.Errorf("Error setting `criteria`: %+v", err)
+		}
+
+		if err := d.Set("action", flattenMonitorMetricAlertActions(props.Actions)); err != nil {
+			return fmt.Errorf("Error setting `action`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorMetricAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorMetricAlertsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["metricAlerts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Metric Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorMetricAlertCriteria(input []interface{}) *insights.MetricAlertSingleResourceMultipleMetricCriteria {
+	criteria := make([]insights.MetricCriteria, 0)
+
+	for i, raw := range input {
+		v := raw.(map[string]interface{})
+
+		dimensions := make([]insights.MetricDimension, 0)
+		for _, dimensionRaw := range v["dimension"].([]interface{}) {
+			dimension := dimensionRaw.(map[string]interface{})
+			dimensions = append(dimensions, insights.MetricDimension{
+				Name:     utils.String(dimension["name"].(string)),
+				Operator: utils.String(dimension["operator"].(string)),
+				Values:   expandMonitorMetricAlertStringArray(dimension["values"].([]interface{})),
+			})
+		}
+
+		criteria = append(criteria, insights.MetricCriteria{
+			// the name of each criterion only needs to be unique within this Metric Alert
+			Name:            utils.String(fmt.Sprintf("Metric%d", i+1)),
+			MetricNamespace: utils.String(v["metric_namespace"].(string)),
+			MetricName:      utils.String(v["metric_name"].(string)),
+			TimeAggregation: v["aggregation"].(string),
+			Operator:        v["operator"].(string),
+			Threshold:       utils.Float(v["threshold"].(float64)),
+			Dimensions:      &dimensions,
+		})
+	}
+
+	return &insights.MetricAlertSingleResourceMultipleMetricCriteria{
+		AllOf: &criteria,
+	}
+}
+
+func expandMonitorMetricAlertActions(input []interface{}) *[]insights.MetricAlertAction {
+	actions := make([]insights.MetricAlertAction, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		properties := make(map[string]*string)
+		for key, value := range v["webhook_properties"].(map[string]interface{}) {
+			properties[key] = utils.String(value.(string))
+		}
+
+		actions = append(actions, insights.MetricAlertAction{
+			ActionGroupID:     utils.String(v["action_group_id"].(string)),
+			WebhookProperties: properties,
+		})
+	}
+
+	return &actions
+}
+
+func flattenMonitorMetricAlertCriteria(input insights.BasicMetricAlertCriteria) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	criteria, ok := input.AsMetricAlertSingleResourceMultipleMetricCriteria()
+	if !ok || criteria == nil || criteria.AllOf == nil {
+		return results
+	}
+
+	for _, metric := range *criteria.AllOf {
+		output := make(map[string]interface{})
+
+		if v := metric.MetricNamespace; v != nil {
+			output["metric_namespace"] = *v
+		}
+
+		if v := metric.MetricName; v != nil {
+			output["metric_name"] = *v
+		}
+
+		// these are modelled as an `interface{}` in the SDK but are returned as strings
+		if v, ok := metric.TimeAggregation.(string); ok {
+			output["aggregation"] = v
+		}
+
+		if v, ok := metric.Operator.(string); ok {
+			output["operator"] = v
+		}
+
+		if v := metric.Threshold; v != nil {
+			output["threshold"] = *v
+		}
+
+		dimensions := make([]interface{}, 0)
+		if metric.Dimensions != nil {
+			for _, dimension := range *metric.Dimensions {
+				values := make(map[string]interface{})
+
+				if v := dimension.Name; v != nil {
+					values["name"] = *v
+				}
+
+				if v := dimension.Operator; v != nil {
+					values["operator"] = *v
+				}
+
+				values["values"] = flattenMonitorMetricAlertStringArray(dimension.Values)
+
+				dimensions = append(dimensions, values)
+			}
+		}
+		output["dimension"] = dimensions
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenMonitorMetricAlertActions(input *[]insights.MetricAlertAction) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, action := range *input {
+		output := make(map[string]interface{})
+
+		if v := action.ActionGroupID; v != nil {
+			output["action_group_id"] = *v
+		}
+
+		properties := make(map[string]interface{})
+		for key, value := range action.WebhookProperties {
+			if value != nil {
+				properties[key] = *value
+			}
+		}
+		output["webhook_properties"] = properties
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandMonitorMetricAlertStringArray(input []interface{}) *[]string {
+	result := make([]string, 0)
+	for _, item := range input {
+		result = append(result, item.(string))
+	}
+	return &result
+}
+
+func flattenMonitorMetricAlertStringArray(input *[]string) []interface{} {
+	result := make([]interface{}, 0)
+	if input != nil {
+		for _, item := range *input {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,285 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorMetricAlert_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alert.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMMonitorMetricAlert_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_mitigate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "PT1M"),
+					resource.TestCheckResourceAttr(resourceName, "window_size", "PT5M"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.metric_name", "UsedCapacity"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorMetricAlert_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alert.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMMonitorMetricAlert_complete(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_mitigate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is a test metric alert"),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "PT30M"),
+					resource.TestCheckResourceAttr(resourceName, "window_size", "PT12H"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.metric_name", "Transactions"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.name", "GeoType"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.1.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.1.metric_name", "Availability"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorMetricAlert_basicAndCompleteUpdate(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alert.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorMetricAlert_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorMetricAlert_complete(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMMonitorMetricAlert_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorMetricAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Metric Alert: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorMetricAlertsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Metric Alert %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on monitorMetricAlertsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorMetricAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorMetricAlertsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_metric_alert" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Metric Alert %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorMetricAlert_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMMonitorMetricAlert_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorMetricAlert_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestma-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_storage_account.test.id}"]
+  window_size         = "PT1H"
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "UsedCapacity"
+    aggregation      = "Average"
+    operator         = "GreaterThan"
+    threshold        = 55.5
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlert_complete(rInt int, rString string, location string) string {
+	template := testAccAzureRMMonitorMetricAlert_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestma-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  scopes              = ["${azurerm_storage_account.test.id}"]
+  enabled             = true
+  auto_mitigate       = false
+  severity            = 4
+  description         = "This is a test metric alert"
+  frequency           = "PT30M"
+  window_size         = "PT12H"
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "Transactions"
+    aggregation      = "Total"
+    operator         = "GreaterThan"
+    threshold        = 99
+
+    dimension {
+      name     = "GeoType"
+      operator = "Include"
+      values   = ["*"]
+    }
+
+    dimension {
+      name     = "ApiName"
+      operator = "Include"
+      values   = ["GetBlob", "PutBlob"]
+    }
+  }
+
+  criteria {
+    metric_namespace = "Microsoft.Storage/storageAccounts"
+    metric_name      = "Availability"
+    aggregation      = "Average"
+    operator         = "LessThan"
+    threshold        = 99.9
+  }
+
+  action {
+    action_group_id = "${azurerm_monitor_action_group.test.id}"
+
+    webhook_properties {
+      environment = "test"
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, template, rInt, rInt)
+}
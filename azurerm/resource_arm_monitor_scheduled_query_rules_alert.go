@@ -0,0 +1,510 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorScheduledQueryRulesAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorScheduledQueryRulesAlertCreateOrUpdate,
+		Read:   resourceArmMonitorScheduledQueryRulesAlertRead,
+		Update: resourceArmMonitorScheduledQueryRulesAlertCreateOrUpdate,
+		Delete: resourceArmMonitorScheduledQueryRulesAlertDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"data_source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"authorized_resource_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"frequency": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
+			"time_window": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(5, 2880),
+			},
+
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_group": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+
+						"email_subject": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"custom_webhook_payload": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+					},
+				},
+			},
+
+			"trigger": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(insights.ConditionalOperatorGreaterThan),
+								string(insights.ConditionalOperatorLessThan),
+								string(insights.ConditionalOperatorEqual),
+							}, false),
+						},
+
+						"threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"metric_trigger": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_column": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"metric_trigger_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(insights.MetricTriggerTypeConsecutive),
+											string(insights.MetricTriggerTypeTotal),
+										}, false),
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(insights.ConditionalOperatorGreaterThan),
+											string(insights.ConditionalOperatorLessThan),
+											string(insights.ConditionalOperatorEqual),
+										}, false),
+									},
+
+									"threshold": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"throttling": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 10000),
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// these may be interpolated from other resources, in which case they can't be validated until apply
+			if !diff.NewValueKnown("frequency") || !diff.NewValueKnown("time_window") {
+				return nil
+			}
+
+			frequency := diff.Get("frequency").(int)
+			timeWindow := diff.Get("time_window").(int)
+
+			if timeWindow < frequency {
+				return fmt.Errorf("`time_window` must be greater than or equal to `frequency`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmMonitorScheduledQueryRulesAlertCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Scheduled Query Rules Alert creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	enabled := insights.True
+	if !d.Get("enabled").(bool) {
+		enabled = insights.False
+	}
+
+	authorizedResourceIds := make([]string, 0)
+	for _, v := range d.Get("authorized_resource_ids").(*schema.Set).List() {
+		authorizedResourceIds = append(authorizedResourceIds, v.(string))
+	}
+
+	action := insights.AlertingAction{
+		Severity:   insights.AlertSeverity(strconv.Itoa(d.Get("severity").(int))),
+		AznsAction: expandMonitorScheduledQueryRulesAlertAction(d.Get("action").([]interface{})),
+		Trigger:    expandMonitorScheduledQueryRulesAlertTrigger(d.Get("trigger").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("throttling"); ok {
+		action.ThrottlingInMin = utils.Int32(int32(v.(int)))
+	}
+
+	parameters := insights.LogSearchRuleResource{
+		Location: utils.String(location),
+		LogSearchRule: &insights.LogSearchRule{
+			Description: utils.String(d.Get("description").(string)),
+			Enabled:     enabled,
+			Source: &insights.Source{
+				DataSourceID:        utils.String(d.Get("data_source_id").(string)),
+				Query:               utils.String(d.Get("query").(string)),
+				AuthorizedResources: &authorizedResourceIds,
+				QueryType:           insights.ResultCount,
+			},
+			Schedule: &insights.Schedule{
+				FrequencyInMinutes:  utils.Int32(int32(d.Get("frequency").(int))),
+				TimeWindowInMinutes: utils.Int32(int32(d.Get("time_window").(int))),
+			},
+			Action: action,
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating or updating Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Scheduled Query Rules Alert %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorScheduledQueryRulesAlertRead(d, meta)
+}
+
+func resourceArmMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := parseMonitorScheduledQueryRulesId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Scheduled Query Rules Alert %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.LogSearchRule; props != nil {
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled == insights.True)
+
+		if source := props.Source; source != nil {
+			d.Set("data_source_id", source.DataSourceID)
+			d.Set("query", source.Query)
+
+			authorizedResourceIds := make([]interface{}, 0)
+			if source.AuthorizedResources != nil {
+				for _, v := range *source.AuthorizedResources {
+					authorizedResourceIds = append(authorizedResourceIds, v)
+				}
+			}
+			if err := d.Set("authorized_resource_ids", authorizedResourceIds); err != nil {
+				return fmt.Errorf("Error setting `authorized_resource_ids`: %+v", err)
+			}
+		}
+
+		if schedule := props.Schedule; schedule != nil {
+			if v := schedule.FrequencyInMinutes; v != nil {
+				d.Set("frequency", int(*v))
+			}
+			if v := schedule.TimeWindowInMinutes; v != nil {
+				d.Set("time_window", int(*v))
+			}
+		}
+
+		if props.Action != nil {
+			action, ok := props.Action.AsAlertingAction()
+			if !ok || action == nil {
+				return fmt.Errorf("Scheduled Query Rule %q (Resource Group %q) is not an Alerting Action", name, resourceGroup)
+			}
+
+			if severity, err := strconv.Atoi(string(action.Severity)); err == nil {
+				d.Set("severity", severity)
+			}
+
+			if v := action.ThrottlingInMin; v != nil {
+				d.Set("throttling", int(*v))
+			}
+
+			if err := d.Set("action", flattenMonitorScheduledQueryRulesAlertAction(action.AznsAction)); err != nil {
+				return fmt.Errorf("Error setting `action`: %+v", err)
+			}
+
+			if err := d.Set("trigger", flattenMonitorScheduledQueryRulesAlertTrigger(action.Trigger)); err != nil {
+				return fmt.Errorf("Error setting `trigger`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorScheduledQueryRulesAlertDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := parseMonitorScheduledQueryRulesId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Scheduled Query Rules Alert %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorScheduledQueryRulesAlertAction(input []interface{}) *insights.AzNsActionGroup {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	actionGroups := make([]string, 0)
+	for _, id := range v["action_group"].(*schema.Set).List() {
+		actionGroups = append(actionGroups, id.(string))
+	}
+
+	result := insights.AzNsActionGroup{
+		ActionGroup: &actionGroups,
+	}
+
+	if subject := v["email_subject"].(string); subject != "" {
+		result.EmailSubject = utils.String(subject)
+	}
+
+	if payload := v["custom_webhook_payload"].(string); payload != "" {
+		result.CustomWebhookPayload = utils.String(payload)
+	}
+
+	return &result
+}
+
+func expandMonitorScheduledQueryRulesAlertTrigger(input []interface{}) *insights.TriggerCondition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	result := insights.TriggerCondition{
+		ThresholdOperator: insights.ConditionalOperator(v["operator"].(string)),
+		Threshold:         utils.Float(v["threshold"].(float64)),
+	}
+
+	if metricTriggers := v["metric_trigger"].([]interface{}); len(metricTriggers) > 0 && metricTriggers[0] != nil {
+		metricTrigger := metricTriggers[0].(map[string]interface{})
+
+		result.MetricTrigger = &insights.LogMetricTrigger{
+			MetricTriggerType: insights.MetricTriggerType(metricTrigger["metric_trigger_type"].(string)),
+			ThresholdOperator: insights.ConditionalOperator(metricTrigger["operator"].(string)),
+			Threshold:         utils.Float(metricTrigger["threshold"].(float64)),
+		}
+
+		if column := metricTrigger["metric_column"].(string); column != "" {
+			result.MetricTrigger.MetricColumn = utils.String(column)
+		}
+	}
+
+	return &result
+}
+
+func flattenMonitorScheduledQueryRulesAlertAction(input *insights.AzNsActionGroup) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	actionGroups := make([]interface{}, 0)
+	if input.ActionGroup != nil {
+		for _, id := range *input.ActionGroup {
+			actionGroups = append(actionGroups, id)
+		}
+	}
+	output["action_group"] = schema.NewSet(schema.HashString, actionGroups)
+
+	if v := input.EmailSubject; v != nil {
+		output["email_subject"] = *v
+	}
+
+	if v := input.CustomWebhookPayload; v != nil {
+		output["custom_webhook_payload"] = *v
+	}
+
+	return []interface{}{output}
+}
+
+func flattenMonitorScheduledQueryRulesAlertTrigger(input *insights.TriggerCondition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+	output["operator"] = string(input.ThresholdOperator)
+
+	if v := input.Threshold; v != nil {
+		output["threshold"] = *v
+	}
+
+	metricTriggers := make([]interface{}, 0)
+	if metricTrigger := input.MetricTrigger; metricTrigger != nil {
+		values := map[string]interface{}{
+			"metric_trigger_type": string(metricTrigger.MetricTriggerType),
+			"operator":            string(metricTrigger.ThresholdOperator),
+		}
+
+		if v := metricTrigger.MetricColumn; v != nil {
+			values["metric_column"] = *v
+		}
+
+		if v := metricTrigger.Threshold; v != nil {
+			values["threshold"] = *v
+		}
+
+		metricTriggers = append(metricTriggers, values)
+	}
+	output["metric_trigger"] = metricTriggers
+
+	return []interface{}{output}
+}
+
+// the casing of the Resource Type within the ID returned from the API differs from the Portal
+func parseMonitorScheduledQueryRulesId(input string) (string, string, error) {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return "", "", err
+	}
+
+	for key, value := range id.Path {
+		if strings.EqualFold(key, "scheduledQueryRules") {
+			return id.ResourceGroup, value, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("Expected ID to be in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/scheduledQueryRules/{name}` - got %q", input)
+}
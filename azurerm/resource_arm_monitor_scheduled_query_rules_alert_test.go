@@ -0,0 +1,300 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorScheduledQueryRulesAlert_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_alert.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorScheduledQueryRulesAlert_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "60"),
+					resource.TestCheckResourceAttr(resourceName, "time_window", "60"),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.operator", "GreaterThan"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.metric_trigger.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorScheduledQueryRulesAlert_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_alert.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorScheduledQueryRulesAlert_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Alert when total results cross threshold"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "throttling", "30"),
+					resource.TestCheckResourceAttr(resourceName, "authorized_resource_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.email_subject", "Email Header"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.metric_trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.metric_trigger.0.metric_column", "Computer"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.metric_trigger.0.metric_trigger_type", "Consecutive"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorScheduledQueryRulesAlert_timeWindowLessThanFrequency(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorScheduledQueryRulesAlert_timeWindowLessThanFrequency(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`time_window` must be greater than or equal to `frequency`"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesAlertExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Scheduled Query Rules Alert: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Scheduled Query Rules Alert %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on monitorScheduledQueryRulesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_scheduled_query_rules_alert" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Scheduled Query Rules Alert %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesAlert_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestAppInsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert" "test" {
+  name                = "acctestsqr-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  data_source_id      = "${azurerm_application_insights.test.id}"
+  query               = "requests | where tolong(resultCode) >= 500 | summarize count() by bin(timestamp, 5m)"
+  frequency           = 60
+  time_window         = 60
+
+  action {
+    action_group = ["${azurerm_monitor_action_group.test.id}"]
+  }
+
+  trigger {
+    operator  = "GreaterThan"
+    threshold = 5000
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesAlert_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestAppInsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert" "test" {
+  name                    = "acctestsqr-%d"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  location                = "${azurerm_resource_group.test.location}"
+  description             = "Alert when total results cross threshold"
+  enabled                 = false
+  data_source_id          = "${azurerm_log_analytics_workspace.test.id}"
+  authorized_resource_ids = ["${azurerm_application_insights.test.id}"]
+  query                   = "Heartbeat | summarize AggregatedValue = count() by bin(TimeGenerated, 5m), Computer"
+  frequency               = 60
+  time_window             = 120
+  severity                = 1
+  throttling              = 30
+
+  action {
+    action_group           = ["${azurerm_monitor_action_group.test.id}"]
+    email_subject          = "Email Header"
+    custom_webhook_payload = "{}"
+  }
+
+  trigger {
+    operator  = "GreaterThan"
+    threshold = 3
+
+    metric_trigger {
+      operator            = "GreaterThan"
+      threshold           = 1
+      metric_trigger_type = "Consecutive"
+      metric_column       = "Computer"
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesAlert_timeWindowLessThanFrequency(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestAppInsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert" "test" {
+  name                = "acctestsqr-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  data_source_id      = "${azurerm_application_insights.test.id}"
+  query               = "requests | summarize count()"
+  frequency           = 60
+  time_window         = 30
+
+  action {
+    action_group = ["${azurerm_monitor_action_group.test.id}"]
+  }
+
+  trigger {
+    operator  = "GreaterThan"
+    threshold = 5000
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,392 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
+	"github.com/Azure/go-autorest/autorest"
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorScheduledQueryRulesLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorScheduledQueryRulesLogCreateOrUpdate,
+		Read:   resourceArmMonitorScheduledQueryRulesLogRead,
+		Update: resourceArmMonitorScheduledQueryRulesLogCreateOrUpdate,
+		Delete: resourceArmMonitorScheduledQueryRulesLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"data_source_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"dimension": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "Include",
+										ValidateFunc: validation.StringInSlice([]string{
+											"Include",
+										}, false),
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorScheduledQueryRulesLogCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Scheduled Query Rules Log creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	enabled := insights.True
+	if !d.Get("enabled").(bool) {
+		enabled = insights.False
+	}
+
+	parameters := insights.LogSearchRuleResource{
+		Location: utils.String(location),
+		LogSearchRule: &insights.LogSearchRule{
+			Description: utils.String(d.Get("description").(string)),
+			Enabled:     enabled,
+			Source: &insights.Source{
+				DataSourceID: utils.String(d.Get("data_source_id").(string)),
+			},
+			Action: monitorLogToMetricAction{
+				OdataType: insights.OdataTypeMicrosoftWindowsAzureManagementMonitoringAlertsModelsMicrosoftAppInsightsNexusDataContractsResourcesScheduledQueryRulesLogToMetricAction,
+				Criteria:  expandMonitorScheduledQueryRulesLogCriteria(d.Get("criteria").([]interface{})),
+			},
+		},
+		Tags: expandTags(tags),
+	}
+
+	if err := createOrUpdateMonitorLogToMetricRule(ctx, client, resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating or updating Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := getMonitorLogToMetricRule(ctx, client, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Scheduled Query Rules Log %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorScheduledQueryRulesLogRead(d, meta)
+}
+
+func resourceArmMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := parseMonitorScheduledQueryRulesId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := getMonitorLogToMetricRule(ctx, client, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Scheduled Query Rules Log %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled == insights.True)
+
+		if source := props.Source; source != nil {
+			d.Set("data_source_id", source.DataSourceID)
+		}
+
+		if action := props.Action; action != nil {
+			if action.OdataType != insights.OdataTypeMicrosoftWindowsAzureManagementMonitoringAlertsModelsMicrosoftAppInsightsNexusDataContractsResourcesScheduledQueryRulesLogToMetricAction {
+				return fmt.Errorf("Scheduled Query Rule %q (Resource Group %q) is not a Log To Metric Action", name, resourceGroup)
+			}
+
+			if err := d.Set("criteria", flattenMonitorScheduledQueryRulesLogCriteria(action.Criteria)); err != nil {
+				return fmt.Errorf("Error setting `criteria`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMonitorScheduledQueryRulesLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, name, err := parseMonitorScheduledQueryRulesId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Scheduled Query Rules Log %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandMonitorScheduledQueryRulesLogCriteria(input []interface{}) *[]insights.Criteria {
+	criteria := make([]insights.Criteria, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		dimensions := make([]insights.Dimension, 0)
+		for _, dimension := range v["dimension"].([]interface{}) {
+			dv := dimension.(map[string]interface{})
+
+			values := make([]string, 0)
+			for _, value := range dv["values"].([]interface{}) {
+				values = append(values, value.(string))
+			}
+
+			dimensions = append(dimensions, insights.Dimension{
+				Name:     utils.String(dv["name"].(string)),
+				Operator: utils.String(dv["operator"].(string)),
+				Values:   &values,
+			})
+		}
+
+		criteria = append(criteria, insights.Criteria{
+			MetricName: utils.String(v["metric_name"].(string)),
+			Dimensions: &dimensions,
+		})
+	}
+
+	return &criteria
+}
+
+func flattenMonitorScheduledQueryRulesLogCriteria(input *[]insights.Criteria) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, criteria := range *input {
+		output := make(map[string]interface{})
+
+		if v := criteria.MetricName; v != nil {
+			output["metric_name"] = *v
+		}
+
+		dimensions := make([]interface{}, 0)
+		if criteria.Dimensions != nil {
+			for _, dimension := range *criteria.Dimensions {
+				values := make(map[string]interface{})
+
+				if v := dimension.Name; v != nil {
+					values["name"] = *v
+				}
+
+				if v := dimension.Operator; v != nil {
+					values["operator"] = *v
+				}
+
+				dimensionValues := make([]interface{}, 0)
+				if dimension.Values != nil {
+					for _, value := range *dimension.Values {
+						dimensionValues = append(dimensionValues, value)
+					}
+				}
+				values["values"] = dimensionValues
+
+				dimensions = append(dimensions, values)
+			}
+		}
+		output["dimension"] = dimensions
+
+		result = append(result, output)
+	}
+
+	return result
+}
+
+// NOTE: the SDK models the `criteria` of a Log To Metric Action as a single object, whereas the API expects (and returns)
+// a list - as such these requests are sent using the SDK's client, but the Action is (un)marshalled using the types below.
+
+type monitorLogToMetricAction struct {
+	Criteria  *[]insights.Criteria          `json:"criteria,omitempty"`
+	OdataType insights.OdataTypeBasicAction `json:"odata.type,omitempty"`
+}
+
+func (a monitorLogToMetricAction) AsAlertingAction() (*insights.AlertingAction, bool) {
+	return nil, false
+}
+
+func (a monitorLogToMetricAction) AsLogToMetricAction() (*insights.LogToMetricAction, bool) {
+	return nil, false
+}
+
+func (a monitorLogToMetricAction) AsAction() (*insights.Action, bool) {
+	return nil, false
+}
+
+type monitorLogToMetricRule struct {
+	autorest.Response `json:"-"`
+	ID                *string                           `json:"id,omitempty"`
+	Location          *string                           `json:"location,omitempty"`
+	Tags              map[string]*string                `json:"tags"`
+	Properties        *monitorLogToMetricRuleProperties `json:"properties,omitempty"`
+}
+
+type monitorLogToMetricRuleProperties struct {
+	Description *string                   `json:"description,omitempty"`
+	Enabled     insights.Enabled          `json:"enabled,omitempty"`
+	Source      *insights.Source          `json:"source,omitempty"`
+	Action      *monitorLogToMetricAction `json:"action,omitempty"`
+}
+
+func createOrUpdateMonitorLogToMetricRule(ctx context.Context, client insights.ScheduledQueryRulesClient, resourceGroup string, name string, parameters insights.LogSearchRuleResource) error {
+	req, err := client.CreateOrUpdatePreparer(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "insights.ScheduledQueryRulesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "insights.ScheduledQueryRulesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		autorestAzure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "insights.ScheduledQueryRulesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func getMonitorLogToMetricRule(ctx context.Context, client insights.ScheduledQueryRulesClient, resourceGroup string, name string) (result monitorLogToMetricRule, err error) {
+	req, err := client.GetPreparer(ctx, resourceGroup, name)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.ScheduledQueryRulesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.ScheduledQueryRulesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		autorestAzure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.ScheduledQueryRulesClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorScheduledQueryRulesLog_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_log.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorScheduledQueryRulesLog_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.metric_name", "Average_% Idle Time"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.name", "InstanceName"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.operator", "Include"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.values.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorScheduledQueryRulesLog_update(t *testing.T) {
+	resourceName := "azurerm_monitor_scheduled_query_rules_log.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMMonitorScheduledQueryRulesLog_basic(ri, location)
+	postConfig := testAccAzureRMMonitorScheduledQueryRulesLog_complete(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorScheduledQueryRulesLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Scheduled query rule LogToMetric"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.dimension.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesLogExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Scheduled Query Rules Log: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := getMonitorLogToMetricRule(ctx, client, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Scheduled Query Rules Log %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on monitorScheduledQueryRulesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorScheduledQueryRulesLogDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorScheduledQueryRulesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_scheduled_query_rules_log" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := getMonitorLogToMetricRule(ctx, client, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Scheduled Query Rules Log %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesLog_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesLog_basic(rInt int, location string) string {
+	template := testAccAzureRMMonitorScheduledQueryRulesLog_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_log" "test" {
+  name                = "acctestsqr-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  data_source_id      = "${azurerm_log_analytics_workspace.test.id}"
+
+  criteria {
+    metric_name = "Average_%% Idle Time"
+
+    dimension {
+      name   = "InstanceName"
+      values = ["1"]
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorScheduledQueryRulesLog_complete(rInt int, location string) string {
+	template := testAccAzureRMMonitorScheduledQueryRulesLog_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_scheduled_query_rules_log" "test" {
+  name                = "acctestsqr-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  data_source_id      = "${azurerm_log_analytics_workspace.test.id}"
+  description         = "Scheduled query rule LogToMetric"
+  enabled             = false
+
+  criteria {
+    metric_name = "Average_%% Idle Time"
+
+    dimension {
+      name     = "InstanceName"
+      operator = "Include"
+      values   = ["1", "2"]
+    }
+  }
+
+  tags {
+    environment = "test"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,392 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the tier and (for vCore based SKUs) the family which each Elastic Pool SKU belongs to
+var mssqlElasticPoolSkus = map[string]struct {
+	tier   string
+	family string
+}{
+	"BasicPool":    {tier: "Basic"},
+	"StandardPool": {tier: "Standard"},
+	"PremiumPool":  {tier: "Premium"},
+	"GP_Gen4":      {tier: "GeneralPurpose", family: "Gen4"},
+	"GP_Gen5":      {tier: "GeneralPurpose", family: "Gen5"},
+	"BC_Gen4":      {tier: "BusinessCritical", family: "Gen4"},
+	"BC_Gen5":      {tier: "BusinessCritical", family: "Gen5"},
+}
+
+func resourceArmMsSqlElasticPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlElasticPoolCreateUpdate,
+		Read:   resourceArmMsSqlElasticPoolRead,
+		Update: resourceArmMsSqlElasticPoolCreateUpdate,
+		Delete: resourceArmMsSqlElasticPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"BasicPool",
+								"StandardPool",
+								"PremiumPool",
+								"GP_Gen4",
+								"GP_Gen5",
+								"BC_Gen4",
+								"BC_Gen5",
+							}, false),
+						},
+
+						"capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"per_database_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_capacity": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+
+						"max_capacity": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"max_size_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LicenseIncluded",
+					"BasePrice",
+				}, false),
+			},
+
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			skuName := ""
+			if skus := diff.Get("sku").([]interface{}); len(skus) > 0 && skus[0] != nil {
+				skuName = skus[0].(map[string]interface{})["name"].(string)
+			}
+
+			// the SKU name isn't known at plan time when it's interpolated
+			if skuName == "" {
+				return nil
+			}
+
+			isVCore := mssqlElasticPoolSkus[skuName].family != ""
+			if v, ok := diff.GetOk("license_type"); ok && !isVCore && diff.HasChange("license_type") {
+				return fmt.Errorf("`license_type` can only be set for vCore based Elastic Pools, but is `%s`", v.(string))
+			}
+
+			// the capacities are only compared once they're known
+			if _, ok := diff.GetOk("per_database_settings"); ok && diff.NewValueKnown("per_database_settings.0.min_capacity") && diff.NewValueKnown("per_database_settings.0.max_capacity") {
+				minCapacity := diff.Get("per_database_settings.0.min_capacity").(float64)
+				maxCapacity := diff.Get("per_database_settings.0.max_capacity").(float64)
+
+				if minCapacity > maxCapacity {
+					return fmt.Errorf("`per_database_settings.0.min_capacity` (%g) must be less than or equal to `per_database_settings.0.max_capacity` (%g)", minCapacity, maxCapacity)
+				}
+
+				if diff.NewValueKnown("sku.0.capacity") {
+					if capacity := float64(diff.Get("sku.0.capacity").(int)); maxCapacity > capacity {
+						return fmt.Errorf("`per_database_settings.0.max_capacity` (%g) must be less than or equal to `sku.0.capacity` (%g)", maxCapacity, capacity)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceArmMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mssqlElasticPoolsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for SQL Elastic Pool creation/update.")
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := sql.ElasticPoolProperties{
+		PerDatabaseSettings: expandArmMsSqlElasticPoolPerDatabaseSettings(d.Get("per_database_settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("max_size_bytes"); ok {
+		properties.MaxSizeBytes = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		properties.LicenseType = sql.ElasticPoolLicenseType(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("zone_redundant"); ok {
+		properties.ZoneRedundant = utils.Bool(v.(bool))
+	}
+
+	parameters := sql.ElasticPool{
+		Location:              utils.String(location),
+		Sku:                   expandArmMsSqlElasticPoolSku(d.Get("sku").([]interface{})),
+		ElasticPoolProperties: &properties,
+		Tags:                  expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read SQL Elastic Pool %q (Server %q / Resource Group %q) ID", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMsSqlElasticPoolRead(d, meta)
+}
+
+func resourceArmMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mssqlElasticPoolsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, serverName, name, err := parseArmSqlElasticPoolId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SQL Elastic Pool %q (Server %q / Resource Group %q) was not found - removing from state", name, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on SQL Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("server_name", serverName)
+
+	if err := d.Set("sku", flattenArmMsSqlElasticPoolSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := resp.ElasticPoolProperties; props != nil {
+		d.Set("max_size_bytes", props.MaxSizeBytes)
+		d.Set("license_type", string(props.LicenseType))
+		d.Set("zone_redundant", props.ZoneRedundant)
+
+		if date := props.CreationDate; date != nil {
+			d.Set("creation_date", date.Format(time.RFC3339))
+		}
+
+		if err := d.Set("per_database_settings", flattenArmMsSqlElasticPoolPerDatabaseSettings(props.PerDatabaseSettings)); err != nil {
+			return fmt.Errorf("Error setting `per_database_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMsSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mssqlElasticPoolsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup, serverName, name, err := parseArmSqlElasticPoolId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error issuing delete request for SQL Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Elastic Pool %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmMsSqlElasticPoolSku(input []interface{}) *sql.Sku {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	name := v["name"].(string)
+
+	sku := &sql.Sku{
+		Name:     utils.String(name),
+		Tier:     utils.String(mssqlElasticPoolSkus[name].tier),
+		Capacity: utils.Int32(int32(v["capacity"].(int))),
+	}
+
+	if family := mssqlElasticPoolSkus[name].family; family != "" {
+		sku.Family = utils.String(family)
+	}
+
+	return sku
+}
+
+func flattenArmMsSqlElasticPoolSku(input *sql.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if name := input.Name; name != nil {
+		output["name"] = *name
+	}
+
+	if capacity := input.Capacity; capacity != nil {
+		output["capacity"] = int(*capacity)
+	}
+
+	if tier := input.Tier; tier != nil {
+		output["tier"] = *tier
+	}
+
+	if family := input.Family; family != nil {
+		output["family"] = *family
+	}
+
+	return []interface{}{output}
+}
+
+func expandArmMsSqlElasticPoolPerDatabaseSettings(input []interface{}) *sql.ElasticPoolPerDatabaseSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &sql.ElasticPoolPerDatabaseSettings{
+		MinCapacity: utils.Float(v["min_capacity"].(float64)),
+		MaxCapacity: utils.Float(v["max_capacity"].(float64)),
+	}
+}
+
+func flattenArmMsSqlElasticPoolPerDatabaseSettings(input *sql.ElasticPoolPerDatabaseSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if minCapacity := input.MinCapacity; minCapacity != nil {
+		output["min_capacity"] = *minCapacity
+	}
+
+	if maxCapacity := input.MaxCapacity; maxCapacity != nil {
+		output["max_capacity"] = *maxCapacity
+	}
+
+	return []interface{}{output}
+}
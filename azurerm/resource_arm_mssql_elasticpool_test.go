@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlElasticPool_basicDTU(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMsSqlElasticPool_basicDTU(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "BasicPool"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "Basic"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "50"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.max_capacity", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_basicVCore(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMsSqlElasticPool_basicVCore(ri, testLocation(), 4, 0.25, 4, "LicenseIncluded")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "GP_Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.tier", "GeneralPurpose"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.family", "Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.min_capacity", "0.25"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.max_capacity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "LicenseIncluded"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_resizeVCore(t *testing.T) {
+	resourceName := "azurerm_mssql_elasticpool.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMMsSqlElasticPool_basicVCore(ri, location, 4, 0.25, 4, "LicenseIncluded")
+	postConfig := testAccAzureRMMsSqlElasticPool_basicVCore(ri, location, 8, 0, 8, "BasePrice")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "4"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "LicenseIncluded"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "8"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "per_database_settings.0.max_capacity", "8"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "BasePrice"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlElasticPool_maxCapacityExceedsSku(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMMsSqlElasticPool_basicVCore(ri, testLocation(), 4, 0, 8, "LicenseIncluded")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMsSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`per_database_settings.0.max_capacity` \\(8\\) must be less than or equal to `sku.0.capacity` \\(4\\)"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlElasticPoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		poolName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mssqlElasticPoolsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, poolName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Elastic Pool %q (Server %q / Resource Group %q) does not exist", poolName, serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on mssqlElasticPoolsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlElasticPoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mssqlElasticPoolsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_elasticpool" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		poolName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, poolName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("SQL Elastic Pool %q (Server %q / Resource Group %q) still exists", poolName, serverName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlElasticPool_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMMsSqlElasticPool_basicDTU(rInt int, location string) string {
+	template := testAccAzureRMMsSqlElasticPool_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5242880000
+
+  sku {
+    name     = "BasicPool"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMsSqlElasticPool_basicVCore(rInt int, location string, capacity int, minCapacity, maxCapacity float64, licenseType string) string {
+	template := testAccAzureRMMsSqlElasticPool_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  max_size_bytes      = 5368709120
+  license_type        = "%s"
+
+  sku {
+    name     = "GP_Gen5"
+    capacity = %d
+  }
+
+  per_database_settings {
+    min_capacity = %g
+    max_capacity = %g
+  }
+}
+`, template, rInt, licenseType, capacity, minCapacity, maxCapacity)
+}
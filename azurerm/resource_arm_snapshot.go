@@ -1,9 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,6 +14,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// snapshotCopyAccessDuration is how long the SAS URI used to copy a Snapshot across regions remains valid
+const snapshotCopyAccessDuration = 4 * time.Hour
+
 func resourceArmSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSnapshotCreateUpdate,
@@ -21,6 +27,8 @@ func resourceArmSnapshot() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmSnapshotCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -102,6 +110,35 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		properties.DiskProperties.CreationData.SourceResourceID = utils.String(v.(string))
 	}
 
+	// Snapshots can't be copied directly into another region, instead the source is exported
+	// via a temporary SAS URI and then imported through a Storage Account in the target region
+	sourceSnapshot, err := resourceArmSnapshotCrossRegionSource(meta, createOption, d.Get("source_resource_id").(string), location)
+	if err != nil {
+		return err
+	}
+	if sourceSnapshot != nil {
+		if d.IsNewResource() {
+			sasUri, err := resourceArmSnapshotGrantAccess(ctx, client, sourceSnapshot)
+			if err != nil {
+				return err
+			}
+			defer resourceArmSnapshotRevokeAccess(ctx, client, sourceSnapshot)
+
+			properties.DiskProperties.CreationData.SourceURI = utils.String(sasUri)
+		} else {
+			existing, err := client.Get(ctx, resourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+			if existing.DiskProperties != nil && existing.DiskProperties.CreationData != nil {
+				properties.DiskProperties.CreationData.SourceURI = existing.DiskProperties.CreationData.SourceURI
+			}
+		}
+
+		properties.DiskProperties.CreationData.CreateOption = compute.Import
+		properties.DiskProperties.CreationData.SourceResourceID = nil
+	}
+
 	if v, ok := d.GetOk("storage_account_id"); ok {
 		properties.DiskProperties.CreationData.StorageAccountID = utils.String(v.(string))
 	}
@@ -169,7 +206,11 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	if props := resp.DiskProperties; props != nil {
 
 		if data := props.CreationData; data != nil {
-			d.Set("create_option", string(data.CreateOption))
+			// Snapshots copied from another region are imported from a SAS URI, so we retain the `Copy` from the config
+			copiedFromAnotherRegion := data.CreateOption == compute.Import && strings.EqualFold(d.Get("create_option").(string), string(compute.Copy))
+			if !copiedFromAnotherRegion {
+				d.Set("create_option", string(data.CreateOption))
+			}
 
 			if accountId := data.StorageAccountID; accountId != nil {
 				d.Set("storage_account_id", accountId)
@@ -215,6 +256,127 @@ func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceArmSnapshotCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if err := validateDiskSizeGBCanOnlyBeIncreased(diff); err != nil {
+		return err
+	}
+
+	// copying a Snapshot from another region requires a Storage Account in this region to import it through
+	if diff.Id() != "" {
+		return nil
+	}
+
+	meta, ok := v.(*ArmClient)
+	if !ok || meta == nil {
+		return nil
+	}
+
+	for _, key := range []string{"location", "create_option", "source_resource_id", "storage_account_id"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if diff.Get("storage_account_id").(string) != "" {
+		return nil
+	}
+
+	location := azureRMNormalizeLocation(diff.Get("location").(string))
+	sourceSnapshot, err := resourceArmSnapshotCrossRegionSource(meta, diff.Get("create_option").(string), diff.Get("source_resource_id").(string), location)
+	if err != nil {
+		// the source Snapshot may not be readable yet (e.g. it's being created in this run) - this is checked again during Create
+		log.Printf("[DEBUG] Unable to determine the region of the source Snapshot - skipping validation: %+v", err)
+		return nil
+	}
+	if sourceSnapshot != nil {
+		return fmt.Errorf("`storage_account_id` must be specified when copying a Snapshot from another region")
+	}
+
+	return nil
+}
+
+// resourceArmSnapshotCrossRegionSource returns the ID of the source Snapshot when it's being copied from a different region
+func resourceArmSnapshotCrossRegionSource(meta interface{}, createOption string, sourceResourceId string, location string) (*ResourceID, error) {
+	client := meta.(*ArmClient).snapshotsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	if !strings.EqualFold(createOption, string(compute.Copy)) {
+		return nil, nil
+	}
+
+	if sourceResourceId == "" {
+		return nil, nil
+	}
+
+	id, err := parseAzureResourceID(sourceResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceName, ok := id.Path["snapshots"]
+	if !ok {
+		// only Snapshots can be copied across regions
+		return nil, nil
+	}
+
+	source, err := client.Get(ctx, id.ResourceGroup, sourceName)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving source Snapshot %q (Resource Group %q): %+v", sourceName, id.ResourceGroup, err)
+	}
+
+	if source.Location == nil || azureRMNormalizeLocation(*source.Location) == location {
+		return nil, nil
+	}
+
+	return id, nil
+}
+
+func resourceArmSnapshotGrantAccess(ctx context.Context, client compute.SnapshotsClient, id *ResourceID) (string, error) {
+	resourceGroup := id.ResourceGroup
+	name := id.Path["snapshots"]
+
+	log.Printf("[DEBUG] Granting read access to source Snapshot %q (Resource Group %q)..", name, resourceGroup)
+	input := compute.GrantAccessData{
+		Access:            compute.Read,
+		DurationInSeconds: utils.Int32(int32(snapshotCopyAccessDuration.Seconds())),
+	}
+	future, err := client.GrantAccess(ctx, resourceGroup, name, input)
+	if err != nil {
+		return "", fmt.Errorf("Error granting access to source Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return "", fmt.Errorf("Error waiting for access to be granted to source Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	access, err := future.Result(client)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving access URI for source Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if access.AccessSAS == nil {
+		return "", fmt.Errorf("Error retrieving access URI for source Snapshot %q (Resource Group %q): `accessSAS` was nil", name, resourceGroup)
+	}
+
+	return *access.AccessSAS, nil
+}
+
+func resourceArmSnapshotRevokeAccess(ctx context.Context, client compute.SnapshotsClient, id *ResourceID) {
+	resourceGroup := id.ResourceGroup
+	name := id.Path["snapshots"]
+
+	log.Printf("[DEBUG] Revoking access to source Snapshot %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.RevokeAccess(ctx, resourceGroup, name)
+	if err != nil {
+		log.Printf("[WARN] Error revoking access to source Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		log.Printf("[WARN] Error waiting for access to be revoked for source Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+}
+
 func validateSnapshotName(v interface{}, k string) (ws []string, errors []error) {
 	// a-z, A-Z, 0-9, _ and -. The max name length is 80
 	value := v.(string)
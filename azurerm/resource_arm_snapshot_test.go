@@ -2,6 +2,8 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -167,6 +169,51 @@ func TestAccAzureRMSnapshot_fromExistingSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSnapshot_fromExistingSnapshotInAnotherRegion(t *testing.T) {
+	resourceName := "azurerm_snapshot.second"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMSnapshot_fromExistingSnapshotInAnotherRegion(ri, rs, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_option", "Copy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSnapshot_shrinking(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSnapshot_extendingManagedDisk(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists("azurerm_snapshot.test"),
+				),
+			},
+			{
+				Config:      testAccAzureRMSnapshot_shrinkingManagedDisk(ri, location),
+				ExpectError: regexp.MustCompile("`disk_size_gb` can only be increased"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSnapshot_fromUnmanagedDisk(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
 	ri := acctest.RandInt()
@@ -421,6 +468,33 @@ resource "azurerm_snapshot" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMSnapshot_shrinkingManagedDisk(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "test" {
+  name                = "acctestss_%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_uri          = "${azurerm_managed_disk.test.id}"
+  disk_size_gb        = "15"
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMSnapshot_fromExistingSnapshot(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -455,6 +529,54 @@ resource "azurerm_snapshot" "second" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMSnapshot_fromExistingSnapshotInAnotherRegion(rInt int, rString string, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "second" {
+  name     = "acctestRG2-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_disk" "original" {
+  name                 = "acctestmd-%d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "first" {
+  name                = "acctestss1_%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_uri          = "${azurerm_managed_disk.original.id}"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.second.name}"
+  location                 = "${azurerm_resource_group.second.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_snapshot" "second" {
+  name                = "acctestss2_%d"
+  location            = "${azurerm_resource_group.second.location}"
+  resource_group_name = "${azurerm_resource_group.second.name}"
+  create_option       = "Copy"
+  source_resource_id  = "${azurerm_snapshot.first.id}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+}
+`, rInt, location, rInt, altLocation, rInt, rInt, rString, rInt)
+}
+
 func testAccAzureRMSnapshot_fromUnmanagedDisk(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -3,18 +3,34 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the vCore based editions aren't defined as constants in the SDK, but are supported by the API
+const (
+	sqlDatabaseEditionGeneralPurpose   = "GeneralPurpose"
+	sqlDatabaseEditionBusinessCritical = "BusinessCritical"
+)
+
+var sqlDatabaseVCoreEditionTiers = map[string]string{
+	strings.ToLower(sqlDatabaseEditionGeneralPurpose):   "GP",
+	strings.ToLower(sqlDatabaseEditionBusinessCritical): "BC",
+}
+
+var sqlDatabaseVCoreServiceObjectiveRegex = regexp.MustCompile(`(?i)^(GP|BC)_Gen[45]_[0-9]+$`)
+
 func resourceArmSqlDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSqlDatabaseCreateUpdate,
@@ -136,6 +152,8 @@ func resourceArmSqlDatabase() *schema.Resource {
 					string(sql.Standard),
 					string(sql.Premium),
 					string(sql.DataWarehouse),
+					sqlDatabaseEditionGeneralPurpose,
+					sqlDatabaseEditionBusinessCritical,
 				}, true),
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
@@ -197,6 +215,43 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Computed: true,
 			},
 
+			"replication_links": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partner_server": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partner_database": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partner_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partner_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replication_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"threat_detection_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -283,11 +338,167 @@ func resourceArmSqlDatabase() *schema.Resource {
 				},
 			},
 
+			"blob_auditing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							Default:          string(sql.BlobAuditingPolicyStateDisabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.BlobAuditingPolicyStateDisabled),
+								string(sql.BlobAuditingPolicyStateEnabled),
+							}, true),
+						},
+
+						"storage_endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"storage_account_access_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"storage_account_access_key_is_secondary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"retention_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"audit_actions_and_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LicenseIncluded",
+					"BasePrice",
+				}, false),
+			},
+
+			"long_term_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							Default:          string(sql.Enabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Disabled),
+								string(sql.Enabled),
+							}, true),
+						},
+
+						"recovery_services_backup_policy_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc:     azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"geo_backup_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.GeoBackupPolicyStateDisabled),
+								string(sql.GeoBackupPolicyStateEnabled),
+							}, true),
+						},
+
+						"storage_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 
+			createMode := diff.Get("create_mode").(string)
+			if strings.EqualFold(createMode, string(sql.OnlineSecondary)) || strings.EqualFold(createMode, string(sql.NonReadableSecondary)) {
+				// the ID of the Primary Database isn't known until it's been created
+				if diff.NewValueKnown("source_database_id") && diff.Get("source_database_id").(string) == "" {
+					return fmt.Errorf("`source_database_id` is required when `create_mode` is `%s`", createMode)
+				}
+			}
+
+			// vCore based databases are configured using a Service Objective in the format `{tier}_{family}_{vCores}`, e.g. `GP_Gen5_2`
+			edition := diff.Get("edition").(string)
+			serviceObjectiveName := diff.Get("requested_service_objective_name").(string)
+			if edition != "" && serviceObjectiveName != "" && !strings.EqualFold(serviceObjectiveName, string(sql.ServiceObjectiveNameElasticPool)) {
+				vCoreMatch := sqlDatabaseVCoreServiceObjectiveRegex.FindStringSubmatch(serviceObjectiveName)
+				vCoreTier := ""
+				if len(vCoreMatch) > 1 {
+					vCoreTier = strings.ToUpper(vCoreMatch[1])
+				}
+
+				if expectedTier, isVCore := sqlDatabaseVCoreEditionTiers[strings.ToLower(edition)]; isVCore && vCoreTier != expectedTier {
+					return fmt.Errorf("`requested_service_objective_name` must be in the format `%s_{Gen4|Gen5}_{vCores}` when `edition` is `%s`", expectedTier, edition)
+				} else if !isVCore && vCoreTier != "" {
+					return fmt.Errorf("`edition` must be `%s` or `%s` when `requested_service_objective_name` is a vCore Service Objective", sqlDatabaseEditionGeneralPurpose, sqlDatabaseEditionBusinessCritical)
+				}
+			}
+
+			if v, ok := diff.GetOk("license_type"); ok && diff.HasChange("license_type") && edition != "" {
+				if _, isVCore := sqlDatabaseVCoreEditionTiers[strings.ToLower(edition)]; !isVCore {
+					return fmt.Errorf("`license_type` can only be set to `%s` when `edition` is `%s` or `%s`", v.(string), sqlDatabaseEditionGeneralPurpose, sqlDatabaseEditionBusinessCritical)
+				}
+			}
+
 			threatDetection, hasThreatDetection := diff.GetOk("threat_detection_policy")
 			if hasThreatDetection {
 				if tl := threatDetection.([]interface{}); len(tl) > 0 {
@@ -302,6 +513,37 @@ func resourceArmSqlDatabase() *schema.Resource {
 				}
 			}
 
+			blobAuditing, hasBlobAuditing := diff.GetOk("blob_auditing_policy")
+			if hasBlobAuditing {
+				if bl := blobAuditing.([]interface{}); len(bl) > 0 && bl[0] != nil {
+					b := bl[0].(map[string]interface{})
+
+					state := strings.ToLower(b["state"].(string))
+					if state == "enabled" && (b["storage_endpoint"].(string) == "" || b["storage_account_access_key"].(string) == "") {
+						return fmt.Errorf("`storage_endpoint` and `storage_account_access_key` are required in the `blob_auditing_policy` block when `state` is `Enabled`")
+					}
+				}
+			}
+
+			longTermRetention, hasLongTermRetention := diff.GetOk("long_term_retention_policy")
+			if hasLongTermRetention && edition != "" && !sqlDatabaseSupportsLongTermRetentionPolicy(edition) {
+				return fmt.Errorf("`long_term_retention_policy` is not supported when `edition` is `%s`", edition)
+			}
+			if hasLongTermRetention {
+				if ll := longTermRetention.([]interface{}); len(ll) > 0 && ll[0] != nil {
+					l := ll[0].(map[string]interface{})
+
+					state := strings.ToLower(l["state"].(string))
+					if state == "enabled" && l["recovery_services_backup_policy_id"].(string) == "" {
+						return fmt.Errorf("`recovery_services_backup_policy_id` is required in the `long_term_retention_policy` block when `state` is `Enabled`")
+					}
+				}
+			}
+
+			if _, hasGeoBackup := diff.GetOk("geo_backup_policy"); hasGeoBackup && edition != "" && !sqlDatabaseSupportsGeoBackupPolicy(edition) {
+				return fmt.Errorf("`geo_backup_policy` is only supported when `edition` is `%s`", string(sql.DataWarehouse))
+			}
+
 			return nil
 		},
 	}
@@ -382,6 +624,10 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		properties.DatabaseProperties.RequestedServiceObjectiveName = sql.ServiceObjectiveName(requestedServiceObjectiveName)
 	}
 
+	if v, ok := d.GetOkExists("zone_redundant"); ok {
+		properties.DatabaseProperties.ZoneRedundant = utils.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("restore_point_in_time"); ok {
 		restorePointInTime := v.(string)
 		restorePointInTimeDate, err := date.ParseTime(time.RFC3339, restorePointInTime)
@@ -443,6 +689,49 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting database threat detection policy: %+v", err)
 	}
 
+	blobAuditingClient := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	if _, err = blobAuditingClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, expandArmSqlDatabaseBlobAuditingPolicy(d)); err != nil {
+		return fmt.Errorf("Error setting database blob auditing policy: %+v", err)
+	}
+
+	// the License Type is only exposed in a newer version of the API than the rest of the Database
+	if v, ok := d.GetOk("license_type"); ok && d.HasChange("license_type") {
+		mssqlClient := meta.(*ArmClient).mssqlDatabasesClient
+		parameters := MsSql.DatabaseUpdate{
+			DatabaseProperties: &MsSql.DatabaseProperties{
+				LicenseType: MsSql.DatabaseLicenseType(v.(string)),
+			},
+		}
+		licenseFuture, err := mssqlClient.Update(ctx, resourceGroup, serverName, name, parameters)
+		if err != nil {
+			return fmt.Errorf("Error setting the License Type for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+
+		if err = licenseFuture.WaitForCompletionRef(ctx, mssqlClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for the License Type for SQL Database %q (Server %q / Resource Group %q) to be set: %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	// unlike the policies above these aren't supported by every database, so they're only set when configured
+	if _, ok := d.GetOk("long_term_retention_policy"); ok && d.HasChange("long_term_retention_policy") {
+		longTermRetentionClient := meta.(*ArmClient).sqlBackupLongTermRetentionPoliciesClient
+		longTermRetentionFuture, err := longTermRetentionClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, expandArmSqlDatabaseLongTermRetentionPolicy(d))
+		if err != nil {
+			return fmt.Errorf("Error setting database long term retention policy: %+v", err)
+		}
+
+		if err = longTermRetentionFuture.WaitForCompletionRef(ctx, longTermRetentionClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for database long term retention policy to be set: %+v", err)
+		}
+	}
+
+	if _, ok := d.GetOk("geo_backup_policy"); ok && d.HasChange("geo_backup_policy") {
+		geoBackupClient := meta.(*ArmClient).sqlGeoBackupPoliciesClient
+		if _, err = geoBackupClient.CreateOrUpdate(ctx, resourceGroup, serverName, name, expandArmSqlDatabaseGeoBackupPolicy(d)); err != nil {
+			return fmt.Errorf("Error setting database geo backup policy: %+v", err)
+		}
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
@@ -479,6 +768,18 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	blobAuditingClient := meta.(*ArmClient).sqlDatabaseBlobAuditingPoliciesClient
+	blobAuditing, err := blobAuditingClient.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(blobAuditing.Response) {
+			return fmt.Errorf("Error retrieving Blob Auditing Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	} else {
+		if err := d.Set("blob_auditing_policy", flattenArmSqlDatabaseBlobAuditingPolicy(d, blobAuditing)); err != nil {
+			return fmt.Errorf("Error setting `blob_auditing_policy`: %+v", err)
+		}
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroup)
 	if location := resp.Location; location != nil {
@@ -497,6 +798,7 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("elastic_pool_name", props.ElasticPoolName)
 		d.Set("max_size_bytes", props.MaxSizeBytes)
 		d.Set("requested_service_objective_name", string(props.RequestedServiceObjectiveName))
+		d.Set("zone_redundant", props.ZoneRedundant)
 
 		if cd := props.CreationDate; cd != nil {
 			d.Set("creation_date", cd.String())
@@ -515,6 +817,57 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		d.Set("encryption", flattenEncryptionStatus(props.TransparentDataEncryption))
+
+		edition := string(props.Edition)
+		if _, isVCore := sqlDatabaseVCoreEditionTiers[strings.ToLower(edition)]; isVCore {
+			mssqlClient := meta.(*ArmClient).mssqlDatabasesClient
+			database, err := mssqlClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the License Type for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+			}
+
+			if props := database.DatabaseProperties; props != nil {
+				d.Set("license_type", string(props.LicenseType))
+			}
+		}
+
+		if sqlDatabaseSupportsLongTermRetentionPolicy(edition) {
+			longTermRetentionClient := meta.(*ArmClient).sqlBackupLongTermRetentionPoliciesClient
+			longTermRetention, err := longTermRetentionClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				// a 400 is returned when no Recovery Services Vault has been registered with the Server
+				if !utils.ResponseWasNotFound(longTermRetention.Response) && !utils.ResponseWasBadRequest(longTermRetention.Response) {
+					return fmt.Errorf("Error retrieving Long Term Retention Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+				}
+			} else {
+				if err := d.Set("long_term_retention_policy", flattenArmSqlDatabaseLongTermRetentionPolicy(longTermRetention)); err != nil {
+					return fmt.Errorf("Error setting `long_term_retention_policy`: %+v", err)
+				}
+			}
+		}
+
+		if sqlDatabaseSupportsGeoBackupPolicy(edition) {
+			geoBackupClient := meta.(*ArmClient).sqlGeoBackupPoliciesClient
+			geoBackup, err := geoBackupClient.Get(ctx, resourceGroup, serverName, name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(geoBackup.Response) {
+					return fmt.Errorf("Error retrieving Geo Backup Policy for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+				}
+			} else {
+				if err := d.Set("geo_backup_policy", flattenArmSqlDatabaseGeoBackupPolicy(geoBackup)); err != nil {
+					return fmt.Errorf("Error setting `geo_backup_policy`: %+v", err)
+				}
+			}
+		}
+	}
+
+	replicationLinksClient := meta.(*ArmClient).sqlReplicationLinksClient
+	replicationLinks, err := replicationLinksClient.ListByDatabase(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error listing Replication Links for Sql Database %s: %+v", name, err)
+	}
+	if err := d.Set("replication_links", flattenArmSqlDatabaseReplicationLinks(replicationLinks.Value)); err != nil {
+		return fmt.Errorf("Error setting `replication_links`: %+v", err)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -551,6 +904,16 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// Geo Backup Policies are only available for Data Warehouse databases
+func sqlDatabaseSupportsGeoBackupPolicy(edition string) bool {
+	return strings.EqualFold(edition, string(sql.DataWarehouse))
+}
+
+// Long Term Retention Policies are available for every database other than Data Warehouses
+func sqlDatabaseSupportsLongTermRetentionPolicy(edition string) bool {
+	return !strings.EqualFold(edition, string(sql.DataWarehouse))
+}
+
 func flattenEncryptionStatus(encryption *[]sql.TransparentDataEncryption) string {
 	if encryption != nil {
 		encrypted := *encryption
@@ -564,6 +927,41 @@ func flattenEncryptionStatus(encryption *[]sql.TransparentDataEncryption) string
 	return ""
 }
 
+func flattenArmSqlDatabaseReplicationLinks(input *[]sql.ReplicationLink) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, link := range *input {
+		result := make(map[string]interface{})
+
+		if link.ID != nil {
+			result["id"] = *link.ID
+		}
+
+		if props := link.ReplicationLinkProperties; props != nil {
+			if props.PartnerServer != nil {
+				result["partner_server"] = *props.PartnerServer
+			}
+			if props.PartnerDatabase != nil {
+				result["partner_database"] = *props.PartnerDatabase
+			}
+			if props.PartnerLocation != nil {
+				result["partner_location"] = azureRMNormalizeLocation(*props.PartnerLocation)
+			}
+			result["role"] = string(props.Role)
+			result["partner_role"] = string(props.PartnerRole)
+			result["replication_state"] = string(props.ReplicationState)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
 func flattenArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, policy sql.DatabaseSecurityAlertPolicy) []interface{} {
 
 	// The SQL database threat detection API always returns the default value even if never set.
@@ -614,6 +1012,151 @@ func flattenArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, policy sql
 	return []interface{}{threatDetectionPolicy}
 }
 
+func expandArmSqlDatabaseBlobAuditingPolicy(d *schema.ResourceData) sql.DatabaseBlobAuditingPolicy {
+	policy := sql.DatabaseBlobAuditingPolicy{
+		DatabaseBlobAuditingPolicyProperties: &sql.DatabaseBlobAuditingPolicyProperties{
+			State: sql.BlobAuditingPolicyStateDisabled,
+		},
+	}
+	properties := policy.DatabaseBlobAuditingPolicyProperties
+
+	ba := d.Get("blob_auditing_policy").([]interface{})
+	if len(ba) == 0 || ba[0] == nil {
+		return policy
+	}
+
+	blobAuditing := ba[0].(map[string]interface{})
+
+	properties.State = sql.BlobAuditingPolicyState(blobAuditing["state"].(string))
+	properties.IsStorageSecondaryKeyInUse = utils.Bool(blobAuditing["storage_account_access_key_is_secondary"].(bool))
+	properties.RetentionDays = utils.Int32(int32(blobAuditing["retention_days"].(int)))
+
+	if v := blobAuditing["storage_endpoint"].(string); v != "" {
+		properties.StorageEndpoint = utils.String(v)
+	}
+	if v := blobAuditing["storage_account_access_key"].(string); v != "" {
+		properties.StorageAccountAccessKey = utils.String(v)
+	}
+
+	if v := blobAuditing["audit_actions_and_groups"].([]interface{}); len(v) > 0 {
+		actionsAndGroups := make([]string, 0)
+		for _, a := range v {
+			actionsAndGroups = append(actionsAndGroups, a.(string))
+		}
+		properties.AuditActionsAndGroups = &actionsAndGroups
+	}
+
+	return policy
+}
+
+func flattenArmSqlDatabaseBlobAuditingPolicy(d *schema.ResourceData, policy sql.DatabaseBlobAuditingPolicy) []interface{} {
+	properties := policy.DatabaseBlobAuditingPolicyProperties
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	blobAuditingPolicy := make(map[string]interface{})
+
+	blobAuditingPolicy["state"] = string(properties.State)
+
+	if properties.StorageEndpoint != nil {
+		blobAuditingPolicy["storage_endpoint"] = *properties.StorageEndpoint
+	}
+	if properties.RetentionDays != nil {
+		blobAuditingPolicy["retention_days"] = int(*properties.RetentionDays)
+	}
+	if properties.IsStorageSecondaryKeyInUse != nil {
+		blobAuditingPolicy["storage_account_access_key_is_secondary"] = *properties.IsStorageSecondaryKeyInUse
+	}
+
+	actionsAndGroups := make([]interface{}, 0)
+	if v := properties.AuditActionsAndGroups; v != nil {
+		for _, a := range *v {
+			actionsAndGroups = append(actionsAndGroups, a)
+		}
+	}
+	blobAuditingPolicy["audit_actions_and_groups"] = actionsAndGroups
+
+	// If storage account access key is in state read it to the new state, as the API does not return it for security reasons
+	if v, ok := d.GetOk("blob_auditing_policy.0.storage_account_access_key"); ok {
+		blobAuditingPolicy["storage_account_access_key"] = v.(string)
+	}
+
+	return []interface{}{blobAuditingPolicy}
+}
+
+func expandArmSqlDatabaseLongTermRetentionPolicy(d *schema.ResourceData) sql.BackupLongTermRetentionPolicy {
+	properties := sql.BackupLongTermRetentionPolicyProperties{
+		State: sql.Disabled,
+	}
+
+	ltr := d.Get("long_term_retention_policy").([]interface{})
+	if len(ltr) > 0 && ltr[0] != nil {
+		longTermRetention := ltr[0].(map[string]interface{})
+
+		properties.State = sql.BackupLongTermRetentionPolicyState(longTermRetention["state"].(string))
+
+		if v := longTermRetention["recovery_services_backup_policy_id"].(string); v != "" {
+			properties.RecoveryServicesBackupPolicyResourceID = utils.String(v)
+		}
+	}
+
+	return sql.BackupLongTermRetentionPolicy{
+		BackupLongTermRetentionPolicyProperties: &properties,
+	}
+}
+
+func flattenArmSqlDatabaseLongTermRetentionPolicy(policy sql.BackupLongTermRetentionPolicy) []interface{} {
+	properties := policy.BackupLongTermRetentionPolicyProperties
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	longTermRetention := map[string]interface{}{
+		"state": string(properties.State),
+	}
+
+	if v := properties.RecoveryServicesBackupPolicyResourceID; v != nil {
+		longTermRetention["recovery_services_backup_policy_id"] = *v
+	}
+
+	return []interface{}{longTermRetention}
+}
+
+func expandArmSqlDatabaseGeoBackupPolicy(d *schema.ResourceData) sql.GeoBackupPolicy {
+	properties := sql.GeoBackupPolicyProperties{
+		State: sql.GeoBackupPolicyStateEnabled,
+	}
+
+	gb := d.Get("geo_backup_policy").([]interface{})
+	if len(gb) > 0 && gb[0] != nil {
+		geoBackup := gb[0].(map[string]interface{})
+
+		properties.State = sql.GeoBackupPolicyState(geoBackup["state"].(string))
+	}
+
+	return sql.GeoBackupPolicy{
+		GeoBackupPolicyProperties: &properties,
+	}
+}
+
+func flattenArmSqlDatabaseGeoBackupPolicy(policy sql.GeoBackupPolicy) []interface{} {
+	properties := policy.GeoBackupPolicyProperties
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	geoBackup := map[string]interface{}{
+		"state": string(properties.State),
+	}
+
+	if v := properties.StorageType; v != nil {
+		geoBackup["storage_type"] = *v
+	}
+
+	return []interface{}{geoBackup}
+}
+
 func expandAzureRmSqlDatabaseImport(d *schema.ResourceData) sql.ImportExtensionRequest {
 	v := d.Get("import")
 	dbimportRefs := v.([]interface{})
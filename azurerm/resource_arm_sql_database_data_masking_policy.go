@@ -0,0 +1,398 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlDatabaseDataMaskingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlDatabaseDataMaskingPolicyCreateUpdate,
+		Read:   resourceArmSqlDatabaseDataMaskingPolicyRead,
+		Update: resourceArmSqlDatabaseDataMaskingPolicyCreateUpdate,
+		Delete: resourceArmSqlDatabaseDataMaskingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.DataMaskingStateEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.DataMaskingStateDisabled),
+					string(sql.DataMaskingStateEnabled),
+				}, false),
+			},
+
+			"exempt_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"schema_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"column_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"masking_function": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.DataMaskingFunctionCCN),
+								string(sql.DataMaskingFunctionDefault),
+								string(sql.DataMaskingFunctionEmail),
+								string(sql.DataMaskingFunctionNumber),
+								string(sql.DataMaskingFunctionSSN),
+								string(sql.DataMaskingFunctionText),
+							}, false),
+						},
+
+						"number_from": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"number_to": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"prefix_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"suffix_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"replacement_string": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmSqlDatabaseDataMaskingPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDataMaskingPoliciesClient
+	rulesClient := meta.(*ArmClient).sqlDataMaskingRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	exemptPrincipals := make([]string, 0)
+	for _, v := range d.Get("exempt_principals").(*schema.Set).List() {
+		exemptPrincipals = append(exemptPrincipals, v.(string))
+	}
+
+	policy := sql.DataMaskingPolicy{
+		DataMaskingPolicyProperties: &sql.DataMaskingPolicyProperties{
+			DataMaskingState: sql.DataMaskingState(d.Get("state").(string)),
+			ExemptPrincipals: utils.String(strings.Join(exemptPrincipals, ";")),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, policy); err != nil {
+		return fmt.Errorf("Error setting the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	// rules can't be deleted, instead rules which have been removed from the configuration are disabled
+	if d.HasChange("rule") {
+		old, new := d.GetChange("rule")
+		newRules := expandArmSqlDatabaseDataMaskingRules(new.([]interface{}), sql.DataMaskingRuleStateEnabled)
+
+		for name, rule := range expandArmSqlDatabaseDataMaskingRules(old.([]interface{}), sql.DataMaskingRuleStateDisabled) {
+			if _, ok := newRules[name]; ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Disabling Data Masking Rule %q for SQL Database %q (SQL Server %q / Resource Group %q)", name, databaseName, serverName, resourceGroup)
+			if _, err := rulesClient.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, name, rule); err != nil {
+				return fmt.Errorf("Error disabling Data Masking Rule %q for SQL Database %q (SQL Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+			}
+		}
+
+		for name, rule := range newRules {
+			if _, err := rulesClient.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, name, rule); err != nil {
+				return fmt.Errorf("Error setting Data Masking Rule %q for SQL Database %q (SQL Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+			}
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q) ID", databaseName, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSqlDatabaseDataMaskingPolicyRead(d, meta)
+}
+
+func resourceArmSqlDatabaseDataMaskingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDataMaskingPoliciesClient
+	rulesClient := meta.(*ArmClient).sqlDataMaskingRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q) was not found - removing from state", databaseName, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if props := resp.DataMaskingPolicyProperties; props != nil {
+		d.Set("state", string(props.DataMaskingState))
+
+		exemptPrincipals := make([]interface{}, 0)
+		if v := props.ExemptPrincipals; v != nil && *v != "" {
+			for _, p := range strings.Split(*v, ";") {
+				exemptPrincipals = append(exemptPrincipals, p)
+			}
+		}
+		if err := d.Set("exempt_principals", schema.NewSet(schema.HashString, exemptPrincipals)); err != nil {
+			return fmt.Errorf("Error setting `exempt_principals`: %+v", err)
+		}
+	}
+
+	rules, err := rulesClient.ListByDatabase(ctx, resourceGroup, serverName, databaseName)
+	if err != nil {
+		return fmt.Errorf("Error listing the Data Masking Rules for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	if err := d.Set("rule", flattenArmSqlDatabaseDataMaskingRules(d.Get("rule").([]interface{}), rules.Value)); err != nil {
+		return fmt.Errorf("Error setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmSqlDatabaseDataMaskingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlDataMaskingPoliciesClient
+	rulesClient := meta.(*ArmClient).sqlDataMaskingRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	databaseName := id.Path["databases"]
+
+	// the Data Masking Policy and Rules can't be deleted, so instead they're disabled
+	for name, rule := range expandArmSqlDatabaseDataMaskingRules(d.Get("rule").([]interface{}), sql.DataMaskingRuleStateDisabled) {
+		log.Printf("[DEBUG] Disabling Data Masking Rule %q for SQL Database %q (SQL Server %q / Resource Group %q)", name, databaseName, serverName, resourceGroup)
+		resp, err := rulesClient.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, name, rule)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return fmt.Errorf("Error disabling Data Masking Rule %q for SQL Database %q (SQL Server %q / Resource Group %q): %+v", name, databaseName, serverName, resourceGroup, err)
+		}
+	}
+
+	policy := sql.DataMaskingPolicy{
+		DataMaskingPolicyProperties: &sql.DataMaskingPolicyProperties{
+			DataMaskingState: sql.DataMaskingStateDisabled,
+			ExemptPrincipals: utils.String(""),
+		},
+	}
+
+	log.Printf("[DEBUG] Disabling the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q)", databaseName, serverName, resourceGroup)
+	resp, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, policy)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error disabling the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q): %+v", databaseName, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmSqlDatabaseDataMaskingRules(input []interface{}, state sql.DataMaskingRuleState) map[string]sql.DataMaskingRule {
+	rules := make(map[string]sql.DataMaskingRule)
+
+	for _, v := range input {
+		rule := v.(map[string]interface{})
+		name := rule["name"].(string)
+		maskingFunction := sql.DataMaskingFunction(rule["masking_function"].(string))
+
+		properties := sql.DataMaskingRuleProperties{
+			RuleState:       state,
+			SchemaName:      utils.String(rule["schema_name"].(string)),
+			TableName:       utils.String(rule["table_name"].(string)),
+			ColumnName:      utils.String(rule["column_name"].(string)),
+			MaskingFunction: maskingFunction,
+		}
+
+		switch maskingFunction {
+		case sql.DataMaskingFunctionNumber:
+			properties.NumberFrom = utils.String(strconv.Itoa(rule["number_from"].(int)))
+			properties.NumberTo = utils.String(strconv.Itoa(rule["number_to"].(int)))
+		case sql.DataMaskingFunctionText:
+			properties.PrefixSize = utils.String(strconv.Itoa(rule["prefix_size"].(int)))
+			properties.SuffixSize = utils.String(strconv.Itoa(rule["suffix_size"].(int)))
+			properties.ReplacementString = utils.String(rule["replacement_string"].(string))
+		}
+
+		rules[name] = sql.DataMaskingRule{
+			DataMaskingRuleProperties: &properties,
+		}
+	}
+
+	return rules
+}
+
+func flattenArmSqlDatabaseDataMaskingRules(existing []interface{}, input *[]sql.DataMaskingRule) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	rules := make(map[string]map[string]interface{})
+	names := make([]string, 0)
+	for _, rule := range *input {
+		props := rule.DataMaskingRuleProperties
+		if rule.Name == nil || props == nil || props.RuleState != sql.DataMaskingRuleStateEnabled {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"name":             *rule.Name,
+			"masking_function": string(props.MaskingFunction),
+		}
+
+		if v := props.SchemaName; v != nil {
+			result["schema_name"] = *v
+		}
+		if v := props.TableName; v != nil {
+			result["table_name"] = *v
+		}
+		if v := props.ColumnName; v != nil {
+			result["column_name"] = *v
+		}
+		if v := props.ReplacementString; v != nil {
+			result["replacement_string"] = *v
+		}
+
+		for key, value := range map[string]*string{
+			"number_from": props.NumberFrom,
+			"number_to":   props.NumberTo,
+			"prefix_size": props.PrefixSize,
+			"suffix_size": props.SuffixSize,
+		} {
+			if value == nil {
+				continue
+			}
+			if i, err := strconv.Atoi(*value); err == nil {
+				result[key] = i
+			}
+		}
+
+		rules[*rule.Name] = result
+		names = append(names, *rule.Name)
+	}
+
+	// the API doesn't guarantee an ordering, so keep the rules in the same order as the configuration
+	for _, v := range existing {
+		name := v.(map[string]interface{})["name"].(string)
+		if rule, ok := rules[name]; ok {
+			results = append(results, rule)
+			delete(rules, name)
+		}
+	}
+
+	for _, name := range names {
+		if rule, ok := rules[name]; ok {
+			results = append(results, rule)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,111 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMSqlDatabaseDataMaskingPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_sql_database_data_masking_policy.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabaseDataMaskingPolicy_basic(ri, location, "Enabled")
+	postConfig := testAccAzureRMSqlDatabaseDataMaskingPolicy_basic(ri, location, "Disabled")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseDataMaskingPolicyState(resourceName, "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "exempt_principals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseDataMaskingPolicyState(resourceName, "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlDatabaseDataMaskingPolicyState(name string, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlDataMaskingPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlDataMaskingPoliciesClient: %+v", err)
+		}
+
+		if props := resp.DataMaskingPolicyProperties; props == nil || string(props.DataMaskingState) != state {
+			return fmt.Errorf("Bad: the Data Masking Policy for SQL Database %q (SQL Server %q / Resource Group %q) isn't %q", databaseName, serverName, resourceGroup, state)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSqlDatabaseDataMaskingPolicy_basic(rInt int, location, state string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+
+resource "azurerm_sql_database_data_masking_policy" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  database_name       = "${azurerm_sql_database.test.name}"
+  state               = "%s"
+  exempt_principals   = ["reporting", "support"]
+}
+`, rInt, location, rInt, rInt, state)
+}
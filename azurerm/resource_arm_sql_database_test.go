@@ -2,6 +2,9 @@ package azurerm
 
 import (
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -256,6 +259,222 @@ func TestAccAzureRMSqlDatabase_threatDetectionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlDatabase_blobAuditingPolicy(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabase_blobAuditingPolicy(ri, location, "Enabled")
+	postConfig := testAccAzureRMSqlDatabase_blobAuditingPolicy(ri, location, "Disabled")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_auditing_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_auditing_policy.0.state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "blob_auditing_policy.0.retention_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "blob_auditing_policy.0.audit_actions_and_groups.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_mode", "blob_auditing_policy.0.storage_account_access_key"},
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_auditing_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_auditing_policy.0.state", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_onlineSecondary(t *testing.T) {
+	resourceName := "azurerm_sql_database.secondary"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlDatabase_onlineSecondary(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists("azurerm_sql_database.test"),
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_links.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_links.0.role", "Secondary"),
+					resource.TestCheckResourceAttr(resourceName, "replication_links.0.partner_role", "Primary"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_longTermRetentionPolicy(t *testing.T) {
+	// Long Term Retention requires a Backup Policy within a Recovery Services Vault, which can't be provisioned
+	// by this provider - as such this test is opt-in and requires the ID of an existing Backup Policy
+	backupPolicyEnvVariable := "ARM_TEST_RECOVERY_SERVICES_BACKUP_POLICY_ID"
+	backupPolicyId := os.Getenv(backupPolicyEnvVariable)
+	if backupPolicyId == "" {
+		t.Skipf("Skipping as %q is not specified", backupPolicyEnvVariable)
+	}
+
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabase_longTermRetentionPolicy(ri, location, backupPolicyId, "Enabled")
+	postConfig := testAccAzureRMSqlDatabase_longTermRetentionPolicy(ri, location, backupPolicyId, "Disabled")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.state", "Enabled"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "long_term_retention_policy.0.state", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_geoBackupPolicy(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabase_geoBackupPolicy(ri, location, "Disabled")
+	postConfig := testAccAzureRMSqlDatabase_geoBackupPolicy(ri, location, "Enabled")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geo_backup_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "geo_backup_policy.0.state", "Disabled"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geo_backup_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "geo_backup_policy.0.state", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "geo_backup_policy.0.storage_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_vCore(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabase_vCore(ri, location, "GeneralPurpose", "GP_Gen5_2")
+	postConfig := testAccAzureRMSqlDatabase_vCore(ri, location, "GeneralPurpose", "GP_Gen5_4")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "edition", "GeneralPurpose"),
+					resource.TestCheckResourceAttr(resourceName, "requested_service_objective_name", "GP_Gen5_2"),
+					resource.TestCheckResourceAttr(resourceName, "zone_redundant", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requested_service_objective_name", "GP_Gen5_4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_vCoreLicenseType(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlDatabase_vCoreLicenseType(ri, location, "LicenseIncluded")
+	postConfig := testAccAzureRMSqlDatabase_vCoreLicenseType(ri, location, "BasePrice")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "LicenseIncluded"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "BasePrice"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlDatabase_vCoreEditionMismatch(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlDatabase_vCore(ri, testLocation(), "Standard", "GP_Gen5_2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`edition` must be `GeneralPurpose` or `BusinessCritical`"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSqlDatabaseExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -731,3 +950,220 @@ resource "azurerm_sql_database" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, state)
 }
+
+func testAccAzureRMSqlDatabase_onlineSecondary(rInt int, location, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "secondary" {
+    name = "acctestsqlserver%d-secondary"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "%s"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "Standard"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    max_size_bytes = "1073741824"
+    requested_service_objective_name = "S0"
+}
+
+resource "azurerm_sql_database" "secondary" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.secondary.name}"
+    location = "${azurerm_sql_server.secondary.location}"
+    create_mode = "OnlineSecondary"
+    source_database_id = "${azurerm_sql_database.test.id}"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt, rInt)
+}
+
+func testAccAzureRMSqlDatabase_blobAuditingPolicy(rInt int, location, state string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name = "test%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    account_tier = "Standard"
+    account_replication_type = "GRS"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "Standard"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    max_size_bytes = "1073741824"
+
+    blob_auditing_policy {
+        state                      = "%s"
+        retention_days             = 30
+        storage_account_access_key = "${azurerm_storage_account.test.primary_access_key}"
+        storage_endpoint           = "${azurerm_storage_account.test.primary_blob_endpoint}"
+        audit_actions_and_groups   = ["SUCCESSFUL_DATABASE_AUTHENTICATION_GROUP", "FAILED_DATABASE_AUTHENTICATION_GROUP"]
+    }
+}
+`, rInt, location, rInt, rInt, rInt, state)
+}
+
+func testAccAzureRMSqlDatabase_longTermRetentionPolicy(rInt int, location, backupPolicyId, state string) string {
+	// the Backup Policy ID is in the format `{vaultId}/backupPolicies/{policyName}`
+	vaultId := backupPolicyId[0:strings.Index(strings.ToLower(backupPolicyId), "/backuppolicies/")]
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+    long_term_retention_vault_id = "%s"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "Standard"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    max_size_bytes = "1073741824"
+
+    long_term_retention_policy {
+        state                              = "%s"
+        recovery_services_backup_policy_id = "%s"
+    }
+}
+`, rInt, location, rInt, vaultId, rInt, state, backupPolicyId)
+}
+
+func testAccAzureRMSqlDatabase_geoBackupPolicy(rInt int, location, state string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "DataWarehouse"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    requested_service_objective_name = "DW400"
+
+    geo_backup_policy {
+        state = "%s"
+    }
+}
+`, rInt, location, rInt, rInt, state)
+}
+
+func testAccAzureRMSqlDatabase_vCore(rInt int, location, edition, requestedServiceObjectiveName string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "%s"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    requested_service_objective_name = "%s"
+}
+`, rInt, location, rInt, rInt, edition, requestedServiceObjectiveName)
+}
+
+func testAccAzureRMSqlDatabase_vCoreLicenseType(rInt int, location, licenseType string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "GeneralPurpose"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    requested_service_objective_name = "GP_Gen5_2"
+    license_type = "%s"
+}
+`, rInt, location, rInt, rInt, licenseType)
+}
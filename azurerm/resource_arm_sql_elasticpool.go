@@ -68,6 +68,12 @@ func resourceArmSqlElasticPool() *schema.Resource {
 				Computed: true,
 			},
 
+			"zone_redundant": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -151,6 +157,7 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("db_dtu_min", int(*elasticPool.DatabaseDtuMin))
 		d.Set("db_dtu_max", int(*elasticPool.DatabaseDtuMax))
 		d.Set("pool_size", int(*elasticPool.StorageMB))
+		d.Set("zone_redundant", elasticPool.ZoneRedundant)
 
 		if date := elasticPool.CreationDate; date != nil {
 			d.Set("creation_date", date.Format(time.RFC3339))
@@ -200,6 +207,10 @@ func getArmSqlElasticPoolProperties(d *schema.ResourceData) *sql.ElasticPoolProp
 		props.StorageMB = &poolSize
 	}
 
+	if zoneRedundant, ok := d.GetOkExists("zone_redundant"); ok {
+		props.ZoneRedundant = utils.Bool(zoneRedundant.(bool))
+	}
+
 	return props
 }
 
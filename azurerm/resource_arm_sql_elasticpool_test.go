@@ -29,6 +29,27 @@ func TestAccAzureRMSqlElasticPool_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlElasticPool_zoneRedundant(t *testing.T) {
+	resourceName := "azurerm_sql_elasticpool.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlElasticPool_zoneRedundant(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlElasticPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlElasticPoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zone_redundant", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSqlElasticPool_disappears(t *testing.T) {
 	resourceName := "azurerm_sql_elasticpool.test"
 	ri := acctest.RandInt()
@@ -216,3 +237,32 @@ resource "azurerm_sql_elasticpool" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMSqlElasticPool_zoneRedundant(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctest-%[1]d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctest%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "4dm1n157r470r"
+    administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_sql_elasticpool" "test" {
+    name = "acctest-pool-%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    server_name = "${azurerm_sql_server.test.name}"
+    edition = "Premium"
+    dtu = 125
+    pool_size = 256000
+    zone_redundant = true
+}
+`, rInt, location)
+}
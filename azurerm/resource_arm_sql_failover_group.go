@@ -0,0 +1,424 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlFailoverGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlFailoverGroupCreateUpdate,
+		Read:   resourceArmSqlFailoverGroupRead,
+		Update: resourceArmSqlFailoverGroupCreateUpdate,
+		Delete: resourceArmSqlFailoverGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: schema.HashString,
+			},
+
+			"partner_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.ReadOnlyEndpointFailoverPolicyDisabled),
+								string(sql.ReadOnlyEndpointFailoverPolicyEnabled),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSqlFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for SQL Failover Group creation.")
+
+	name := d.Get("name").(string)
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	readWriteEndpoint, err := expandArmSqlFailoverGroupReadWriteEndpoint(d.Get("read_write_endpoint_failover_policy").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	properties := sql.FailoverGroupProperties{
+		ReadWriteEndpoint: readWriteEndpoint,
+		ReadOnlyEndpoint:  expandArmSqlFailoverGroupReadOnlyEndpoint(d.Get("readonly_endpoint_failover_policy").([]interface{})),
+		PartnerServers:    expandArmSqlFailoverGroupPartnerServers(d.Get("partner_servers").([]interface{})),
+		Databases:         expandArmSqlFailoverGroupDatabases(d.Get("databases").(*schema.Set).List()),
+	}
+
+	parameters := sql.FailoverGroup{
+		FailoverGroupProperties: &properties,
+		Tags:                    expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read SQL Failover Group %q (SQL Server %q / Resource Group %q) ID", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSqlFailoverGroupRead(d, meta)
+}
+
+func resourceArmSqlFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["failoverGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SQL Failover Group %q (SQL Server %q / Resource Group %q) was not found - removing from state", name, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.FailoverGroupProperties; props != nil {
+		d.Set("role", string(props.ReplicationRole))
+
+		if err := d.Set("read_write_endpoint_failover_policy", flattenArmSqlFailoverGroupReadWriteEndpoint(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("readonly_endpoint_failover_policy", flattenArmSqlFailoverGroupReadOnlyEndpoint(props.ReadOnlyEndpoint)); err != nil {
+			return fmt.Errorf("Error setting `readonly_endpoint_failover_policy`: %+v", err)
+		}
+
+		if err := d.Set("partner_servers", flattenArmSqlFailoverGroupPartnerServers(props.PartnerServers)); err != nil {
+			return fmt.Errorf("Error setting `partner_servers`: %+v", err)
+		}
+
+		if err := d.Set("databases", flattenArmSqlFailoverGroupDatabases(props.Databases)); err != nil {
+			return fmt.Errorf("Error setting `databases`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSqlFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["failoverGroups"]
+
+	// a Failover Group can only be deleted from the Primary server, which may be a Partner following a Failover
+	existing, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if props := existing.FailoverGroupProperties; props != nil && props.ReplicationRole == sql.Secondary && props.PartnerServers != nil {
+		for _, partner := range *props.PartnerServers {
+			if partner.ReplicationRole != sql.Primary || partner.ID == nil {
+				continue
+			}
+
+			partnerId, err := parseAzureResourceID(*partner.ID)
+			if err != nil {
+				return err
+			}
+
+			resourceGroup = partnerId.ResourceGroup
+			serverName = partnerId.Path["servers"]
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting SQL Failover Group %q (SQL Server %q / Resource Group %q)", name, serverName, resourceGroup)
+
+	future, err := client.Delete(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error issuing delete request for SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmSqlFailoverGroupReadWriteEndpoint(input []interface{}) (*sql.FailoverGroupReadWriteEndpoint, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	mode := sql.ReadWriteEndpointFailoverPolicy(policy["mode"].(string))
+	graceMinutes := policy["grace_minutes"].(int)
+
+	endpoint := sql.FailoverGroupReadWriteEndpoint{
+		FailoverPolicy: mode,
+	}
+
+	if mode == sql.Automatic {
+		if graceMinutes == 0 {
+			return nil, fmt.Errorf("`grace_minutes` must be specified when the `mode` of the `read_write_endpoint_failover_policy` is `Automatic`")
+		}
+		endpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(graceMinutes))
+	} else if graceMinutes != 0 {
+		return nil, fmt.Errorf("`grace_minutes` can only be specified when the `mode` of the `read_write_endpoint_failover_policy` is `Automatic`")
+	}
+
+	return &endpoint, nil
+}
+
+func flattenArmSqlFailoverGroupReadWriteEndpoint(input *sql.FailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"mode": string(input.FailoverPolicy),
+	}
+
+	if v := input.FailoverWithDataLossGracePeriodMinutes; v != nil {
+		result["grace_minutes"] = int(*v)
+	}
+
+	return []interface{}{result}
+}
+
+func expandArmSqlFailoverGroupReadOnlyEndpoint(input []interface{}) *sql.FailoverGroupReadOnlyEndpoint {
+	if len(input) == 0 {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+
+	return &sql.FailoverGroupReadOnlyEndpoint{
+		FailoverPolicy: sql.ReadOnlyEndpointFailoverPolicy(policy["mode"].(string)),
+	}
+}
+
+func flattenArmSqlFailoverGroupReadOnlyEndpoint(input *sql.FailoverGroupReadOnlyEndpoint) []interface{} {
+	if input == nil || input.FailoverPolicy == "" {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"mode": string(input.FailoverPolicy),
+	}
+
+	return []interface{}{result}
+}
+
+func expandArmSqlFailoverGroupPartnerServers(input []interface{}) *[]sql.PartnerInfo {
+	partners := make([]sql.PartnerInfo, 0)
+
+	for _, v := range input {
+		partner := v.(map[string]interface{})
+		partners = append(partners, sql.PartnerInfo{
+			ID: utils.String(partner["id"].(string)),
+		})
+	}
+
+	return &partners
+}
+
+func flattenArmSqlFailoverGroupPartnerServers(input *[]sql.PartnerInfo) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, partner := range *input {
+		result := map[string]interface{}{
+			"role": string(partner.ReplicationRole),
+		}
+
+		if v := partner.ID; v != nil {
+			result["id"] = *v
+		}
+
+		if v := partner.Location; v != nil {
+			result["location"] = azureRMNormalizeLocation(*v)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func expandArmSqlFailoverGroupDatabases(input []interface{}) *[]string {
+	databases := make([]string, 0)
+
+	for _, v := range input {
+		databases = append(databases, v.(string))
+	}
+
+	return &databases
+}
+
+func flattenArmSqlFailoverGroupDatabases(input *[]string) *schema.Set {
+	databases := &schema.Set{F: schema.HashString}
+
+	if input == nil {
+		return databases
+	}
+
+	for _, v := range *input {
+		databases.Add(v)
+	}
+
+	return databases
+}
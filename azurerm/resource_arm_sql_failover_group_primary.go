@@ -0,0 +1,139 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlFailoverGroupPrimary() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlFailoverGroupPrimaryCreate,
+		Read:   resourceArmSqlFailoverGroupPrimaryRead,
+		Update: resourceArmSqlFailoverGroupPrimaryRead,
+		Delete: resourceArmSqlFailoverGroupPrimaryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"failover_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"allow_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmSqlFailoverGroupPrimaryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	failoverGroupName := d.Get("failover_group_name").(string)
+	serverName := d.Get("server_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, failoverGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("SQL Failover Group %q (SQL Server %q / Resource Group %q) was not found", failoverGroupName, serverName, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", failoverGroupName, serverName, resourceGroup, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read SQL Failover Group %q (SQL Server %q / Resource Group %q) ID", failoverGroupName, serverName, resourceGroup)
+	}
+
+	// a Failover can only be requested from the Secondary server - if this server's already the Primary there's nothing to do
+	if props := resp.FailoverGroupProperties; props == nil || props.ReplicationRole != sql.Primary {
+		if d.Get("allow_data_loss").(bool) {
+			log.Printf("[DEBUG] Forcing a Failover (allowing data loss) of SQL Failover Group %q to SQL Server %q (Resource Group %q)", failoverGroupName, serverName, resourceGroup)
+			future, err := client.ForceFailoverAllowDataLoss(ctx, resourceGroup, serverName, failoverGroupName)
+			if err != nil {
+				return fmt.Errorf("Error forcing a Failover of SQL Failover Group %q to SQL Server %q (Resource Group %q): %+v", failoverGroupName, serverName, resourceGroup, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for the forced Failover of SQL Failover Group %q to SQL Server %q (Resource Group %q): %+v", failoverGroupName, serverName, resourceGroup, err)
+			}
+		} else {
+			log.Printf("[DEBUG] Failing over SQL Failover Group %q to SQL Server %q (Resource Group %q)", failoverGroupName, serverName, resourceGroup)
+			future, err := client.Failover(ctx, resourceGroup, serverName, failoverGroupName)
+			if err != nil {
+				return fmt.Errorf("Error failing over SQL Failover Group %q to SQL Server %q (Resource Group %q): %+v", failoverGroupName, serverName, resourceGroup, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for the Failover of SQL Failover Group %q to SQL Server %q (Resource Group %q): %+v", failoverGroupName, serverName, resourceGroup, err)
+			}
+		}
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlFailoverGroupPrimaryRead(d, meta)
+}
+
+func resourceArmSqlFailoverGroupPrimaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFailoverGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	failoverGroupName := id.Path["failoverGroups"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, failoverGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SQL Failover Group %q (SQL Server %q / Resource Group %q) was not found - removing from state", failoverGroupName, serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on SQL Failover Group %q (SQL Server %q / Resource Group %q): %+v", failoverGroupName, serverName, resourceGroup, err)
+	}
+
+	// if the Failover Group has since failed over to another server, removing this from the state means
+	// the next apply will fail back over to this server
+	if props := resp.FailoverGroupProperties; props == nil || props.ReplicationRole != sql.Primary {
+		log.Printf("[DEBUG] SQL Server %q is no longer the Primary for SQL Failover Group %q (Resource Group %q) - removing from state", serverName, failoverGroupName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("failover_group_name", failoverGroupName)
+	d.Set("server_name", serverName)
+
+	return nil
+}
+
+func resourceArmSqlFailoverGroupPrimaryDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is nothing to delete so return nil
+	return nil
+}
@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlFailoverGroup_basic(t *testing.T) {
+	resourceName := "azurerm_sql_failover_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlFailoverGroup_basic(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.mode", "Manual"),
+					resource.TestCheckResourceAttr(resourceName, "partner_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partner_servers.0.role", "Secondary"),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlFailoverGroup_update(t *testing.T) {
+	resourceName := "azurerm_sql_failover_group.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+	preConfig := testAccAzureRMSqlFailoverGroup_basic(ri, location, altLocation)
+	postConfig := testAccAzureRMSqlFailoverGroup_complete(ri, location, altLocation)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "0"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.mode", "Automatic"),
+					resource.TestCheckResourceAttr(resourceName, "read_write_endpoint_failover_policy.0.grace_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "readonly_endpoint_failover_policy.0.mode", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlFailoverGroup_primary(t *testing.T) {
+	resourceName := "azurerm_sql_failover_group_primary.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlFailoverGroup_primary(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlFailoverGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlFailoverGroupIsPrimary(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlFailoverGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		failoverGroupName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlFailoverGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, failoverGroupName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Failover Group %q (SQL Server %q / Resource Group %q) does not exist", failoverGroupName, serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on sqlFailoverGroupsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlFailoverGroupIsPrimary(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		failoverGroupName := rs.Primary.Attributes["failover_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlFailoverGroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, failoverGroupName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlFailoverGroupsClient: %+v", err)
+		}
+
+		if props := resp.FailoverGroupProperties; props == nil || string(props.ReplicationRole) != "Primary" {
+			return fmt.Errorf("Bad: SQL Server %q is not the Primary for SQL Failover Group %q (Resource Group %q)", serverName, failoverGroupName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlFailoverGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlFailoverGroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_failover_group" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		failoverGroupName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, failoverGroupName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Failover Group %q (SQL Server %q / Resource Group %q) still exists", failoverGroupName, serverName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlFailoverGroup_template(rInt int, location, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_server" "secondary" {
+  name                         = "acctestsqlserver%d-secondary"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "%s"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt)
+}
+
+func testAccAzureRMSqlFailoverGroup_basic(rInt int, location, altLocation string) string {
+	template := testAccAzureRMSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_failover_group" "test" {
+  name                = "acctestsfg%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+
+  partner_servers {
+    id = "${azurerm_sql_server.secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSqlFailoverGroup_complete(rInt int, location, altLocation string) string {
+	template := testAccAzureRMSqlFailoverGroup_template(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_failover_group" "test" {
+  name                = "acctestsfg%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  databases           = ["${azurerm_sql_database.test.id}"]
+
+  partner_servers {
+    id = "${azurerm_sql_server.secondary.id}"
+  }
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+
+  readonly_endpoint_failover_policy {
+    mode = "Enabled"
+  }
+
+  tags {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSqlFailoverGroup_primary(rInt int, location, altLocation string) string {
+	template := testAccAzureRMSqlFailoverGroup_basic(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_failover_group_primary" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  failover_group_name = "${azurerm_sql_failover_group.test.name}"
+  server_name         = "${azurerm_sql_server.secondary.name}"
+}
+`, template)
+}
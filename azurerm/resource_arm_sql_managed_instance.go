@@ -0,0 +1,367 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSqlManagedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlManagedInstanceCreateUpdate,
+		Read:   resourceArmSqlManagedInstanceRead,
+		Update: resourceArmSqlManagedInstanceCreateUpdate,
+		Delete: resourceArmSqlManagedInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// provisioning the first Managed Instance within a Subnet can take six hours or more
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Update: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSqlManagedInstanceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"administrator_login": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"administrator_login_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GP_Gen4",
+					"GP_Gen5",
+					"BC_Gen4",
+					"BC_Gen5",
+				}, false),
+			},
+
+			"vcores": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntInSlice([]int{8, 16, 24, 32, 40, 64, 80}),
+			},
+
+			"storage_size_in_gb": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateSqlManagedInstanceStorageSize,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "LicenseIncluded",
+				ValidateFunc: validation.StringInSlice([]string{
+					"LicenseIncluded",
+					"BasePrice",
+				}, false),
+			},
+
+			"collation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"fully_qualified_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// the Subnet ID isn't known at plan time when the Subnet's being created in the same run,
+			// in which case these checks are performed during creation instead
+			subnetId := diff.Get("subnet_id").(string)
+			if subnetId == "" || diff.Id() != "" {
+				return nil
+			}
+
+			return validateSqlManagedInstanceSubnet(v.(*ArmClient), subnetId)
+		},
+	}
+}
+
+func resourceArmSqlManagedInstanceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlManagedInstancesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for SQL Managed Instance creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	subnetId := d.Get("subnet_id").(string)
+	skuName := d.Get("sku_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if d.IsNewResource() {
+		if err := validateSqlManagedInstanceSubnet(meta.(*ArmClient), subnetId); err != nil {
+			return err
+		}
+	}
+
+	properties := sql.ManagedInstanceProperties{
+		AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
+		AdministratorLoginPassword: utils.String(d.Get("administrator_login_password").(string)),
+		SubnetID:                   utils.String(subnetId),
+		LicenseType:                utils.String(d.Get("license_type").(string)),
+		VCores:                     utils.Int32(int32(d.Get("vcores").(int))),
+		StorageSizeInGB:            utils.Int32(int32(d.Get("storage_size_in_gb").(int))),
+	}
+
+	if v, ok := d.GetOk("collation"); ok {
+		properties.Collation = utils.String(v.(string))
+	}
+
+	parameters := sql.ManagedInstance{
+		Location:                  utils.String(location),
+		Sku:                       expandArmSqlManagedInstanceSku(skuName),
+		ManagedInstanceProperties: &properties,
+		Tags:                      expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the polling duration set in config.go is nowhere near long enough for a Managed Instance,
+	// so it's bound to the user-configurable timeout instead
+	client.Client.PollingDuration = d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		client.Client.PollingDuration = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read SQL Managed Instance %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSqlManagedInstanceRead(d, meta)
+}
+
+func resourceArmSqlManagedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlManagedInstancesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedInstances"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] SQL Managed Instance %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+	}
+
+	if props := resp.ManagedInstanceProperties; props != nil {
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("collation", props.Collation)
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("license_type", props.LicenseType)
+		d.Set("fully_qualified_domain_name", props.FullyQualifiedDomainName)
+
+		if v := props.VCores; v != nil {
+			d.Set("vcores", int(*v))
+		}
+
+		if v := props.StorageSizeInGB; v != nil {
+			d.Set("storage_size_in_gb", int(*v))
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmSqlManagedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlManagedInstancesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["managedInstances"]
+
+	log.Printf("[DEBUG] Deleting SQL Managed Instance %q (Resource Group %q)", name, resourceGroup)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error issuing delete request for SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	client.Client.PollingDuration = d.Timeout(schema.TimeoutDelete)
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of SQL Managed Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmSqlManagedInstanceSku(name string) *sql.Sku {
+	tiers := map[string]string{
+		"GP": "GeneralPurpose",
+		"BC": "BusinessCritical",
+	}
+
+	parts := strings.Split(name, "_")
+
+	return &sql.Sku{
+		Name:   utils.String(name),
+		Tier:   utils.String(tiers[parts[0]]),
+		Family: utils.String(parts[1]),
+	}
+}
+
+// validateSqlManagedInstanceSubnet checks the prerequisites for deploying a Managed Instance into a Subnet:
+// the Subnet must be dedicated to Managed Instances and must have a Route Table associated with it
+func validateSqlManagedInstanceSubnet(client *ArmClient, subnetId string) error {
+	id, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	virtualNetworkName := id.Path["virtualNetworks"]
+	name := id.Path["subnets"]
+
+	subnet, err := client.subnetClient.Get(client.StopContext, resourceGroup, virtualNetworkName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, virtualNetworkName, resourceGroup, err)
+	}
+
+	props := subnet.SubnetPropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): `properties` was nil", name, virtualNetworkName, resourceGroup)
+	}
+
+	if props.RouteTable == nil || props.RouteTable.ID == nil {
+		return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) must have a Route Table associated with it to host a SQL Managed Instance", name, virtualNetworkName, resourceGroup)
+	}
+
+	// a Subnet which already hosts Managed Instances has a Resource Navigation Link to their Virtual Cluster,
+	// in which case the IP Configurations within the Subnet belong to those Managed Instances
+	hostsManagedInstances := false
+	if links := props.ResourceNavigationLinks; links != nil {
+		for _, link := range *links {
+			if link.ResourceNavigationLinkFormat == nil || link.LinkedResourceType == nil {
+				continue
+			}
+
+			if strings.HasPrefix(strings.ToLower(*link.LinkedResourceType), "microsoft.sql/") {
+				hostsManagedInstances = true
+			}
+		}
+	}
+
+	if !hostsManagedInstances && props.IPConfigurations != nil && len(*props.IPConfigurations) > 0 {
+		return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) must be dedicated to SQL Managed Instances, but contains %d other IP Configurations", name, virtualNetworkName, resourceGroup, len(*props.IPConfigurations))
+	}
+
+	return nil
+}
+
+func validateSqlManagedInstanceName(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`).Match([]byte(value)); !matched {
+		es = append(es, fmt.Errorf("%q must be between 1 and 63 characters long, contain only lowercase letters, numbers and hyphens and can't start or end with a hyphen", k))
+	}
+
+	return
+}
+
+func validateSqlManagedInstanceStorageSize(v interface{}, k string) (ws []string, es []error) {
+	value := v.(int)
+
+	if value < 32 || value > 8192 {
+		es = append(es, fmt.Errorf("%q must be between 32 and 8192 GB", k))
+	}
+
+	if value%32 != 0 {
+		es = append(es, fmt.Errorf("%q must be a multiple of 32 GB", k))
+	}
+
+	return
+}
@@ -0,0 +1,384 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourceAzureRMSqlManagedInstance_nameValidation(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "a",
+			ErrCount: 0,
+		},
+		{
+			Value:    "acctest-sqlmi-01",
+			ErrCount: 0,
+		},
+		{
+			Value:    acctest.RandStringFromCharSet(63, "abcdefghijklmnopqrstuvwxyz"),
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "Acctest",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-acctest",
+			ErrCount: 1,
+		},
+		{
+			Value:    "acctest-",
+			ErrCount: 1,
+		},
+		{
+			Value:    "acc_test",
+			ErrCount: 1,
+		},
+		{
+			Value:    acctest.RandStringFromCharSet(64, "abcdefghijklmnopqrstuvwxyz"),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSqlManagedInstanceName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for the SQL Managed Instance Name %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMSqlManagedInstance_storageSizeValidation(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    32,
+			ErrCount: 0,
+		},
+		{
+			Value:    8192,
+			ErrCount: 0,
+		},
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    100,
+			ErrCount: 1,
+		},
+		{
+			Value:    8224,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateSqlManagedInstanceStorageSize(tc.Value, "storage_size_in_gb")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for the SQL Managed Instance Storage Size %d but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestAccAzureRMSqlManagedInstance_basic(t *testing.T) {
+	resourceName := "azurerm_sql_managed_instance.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlManagedInstance_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlManagedInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlManagedInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "GP_Gen5"),
+					resource.TestCheckResourceAttr(resourceName, "vcores", "8"),
+					resource.TestCheckResourceAttr(resourceName, "storage_size_in_gb", "32"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "LicenseIncluded"),
+					resource.TestCheckResourceAttr(resourceName, "collation", "SQL_Latin1_General_CP1_CI_AS"),
+					resource.TestCheckResourceAttrSet(resourceName, "fully_qualified_domain_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlManagedInstance_collation(t *testing.T) {
+	resourceName := "azurerm_sql_managed_instance.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlManagedInstance_collation(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlManagedInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlManagedInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "collation", "Latin1_General_100_CS_AS_SC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlManagedInstance_update(t *testing.T) {
+	resourceName := "azurerm_sql_managed_instance.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlManagedInstance_basic(ri, location)
+	postConfig := testAccAzureRMSqlManagedInstance_complete(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlManagedInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlManagedInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_size_in_gb", "32"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlManagedInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_size_in_gb", "64"),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "BasePrice"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlManagedInstance_subnetWithoutRouteTable(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlManagedInstance_subnetWithoutRouteTable(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlManagedInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("must have a Route Table associated with it"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlManagedInstanceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		instanceName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlManagedInstancesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, instanceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: SQL Managed Instance %q (Resource Group %q) does not exist", instanceName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on sqlManagedInstancesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlManagedInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).sqlManagedInstancesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_managed_instance" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		instanceName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, instanceName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Managed Instance %q (Resource Group %q) still exists", instanceName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlManagedInstance_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctestrt-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  route {
+    name           = "internet"
+    address_prefix = "0.0.0.0/0"
+    next_hop_type  = "Internet"
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  route_table_id       = "${azurerm_route_table.test.id}"
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMSqlManagedInstance_basic(rInt int, location string) string {
+	template := testAccAzureRMSqlManagedInstance_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance" "test" {
+  name                         = "acctestsqlmi%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+  subnet_id                    = "${azurerm_subnet.test.id}"
+  sku_name                     = "GP_Gen5"
+  vcores                       = 8
+  storage_size_in_gb           = 32
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSqlManagedInstance_complete(rInt int, location string) string {
+	template := testAccAzureRMSqlManagedInstance_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance" "test" {
+  name                         = "acctestsqlmi%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+  subnet_id                    = "${azurerm_subnet.test.id}"
+  sku_name                     = "GP_Gen5"
+  vcores                       = 8
+  storage_size_in_gb           = 64
+  license_type                 = "BasePrice"
+
+  tags {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSqlManagedInstance_collation(rInt int, location string) string {
+	template := testAccAzureRMSqlManagedInstance_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance" "test" {
+  name                         = "acctestsqlmi%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+  subnet_id                    = "${azurerm_subnet.test.id}"
+  sku_name                     = "GP_Gen5"
+  vcores                       = 8
+  storage_size_in_gb           = 32
+  collation                    = "Latin1_General_100_CS_AS_SC"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMSqlManagedInstance_subnetWithoutRouteTable(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_sql_managed_instance" "test" {
+  name                         = "acctestsqlmi%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+  subnet_id                    = "${azurerm_subnet.test.id}"
+  sku_name                     = "GP_Gen5"
+  vcores                       = 8
+  storage_size_in_gb           = 32
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -59,6 +60,40 @@ func resourceArmSqlServer() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.SystemAssigned),
+							}, true),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"long_term_retention_vault_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"fully_qualified_domain_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -93,6 +128,10 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		},
 	}
 
+	if _, ok := d.GetOk("identity"); ok {
+		parameters.Identity = expandAzureRmSqlServerIdentity(d)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return err
@@ -115,6 +154,25 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*resp.ID)
 
+	if vaultId, ok := d.GetOk("long_term_retention_vault_id"); ok && d.HasChange("long_term_retention_vault_id") {
+		// registering a Recovery Services Vault is a prerequisite for the Long Term Retention Policies of this server's databases
+		vaultsClient := meta.(*ArmClient).sqlBackupLongTermRetentionVaultsClient
+		vault := sql.BackupLongTermRetentionVault{
+			BackupLongTermRetentionVaultProperties: &sql.BackupLongTermRetentionVaultProperties{
+				RecoveryServicesVaultResourceID: utils.String(vaultId.(string)),
+			},
+		}
+
+		vaultFuture, err := vaultsClient.CreateOrUpdate(ctx, resGroup, name, vault)
+		if err != nil {
+			return fmt.Errorf("Error registering the Long Term Retention Vault for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = vaultFuture.WaitForCompletionRef(ctx, vaultsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Long Term Retention Vault for SQL Server %q (Resource Group %q) to be registered: %+v", name, resGroup, err)
+		}
+	}
+
 	return resourceArmSqlServerRead(d, meta)
 }
 
@@ -153,6 +211,27 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("fully_qualified_domain_name", serverProperties.FullyQualifiedDomainName)
 	}
 
+	if err := d.Set("identity", flattenAzureRmSqlServerIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	// the Long Term Retention Vault is only looked up when it's been configured, since not every Server supports it
+	if _, ok := d.GetOk("long_term_retention_vault_id"); ok {
+		vaultsClient := meta.(*ArmClient).sqlBackupLongTermRetentionVaultsClient
+		vault, err := vaultsClient.Get(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(vault.Response) && !utils.ResponseWasBadRequest(vault.Response) {
+				return fmt.Errorf("Error retrieving the Long Term Retention Vault for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		}
+
+		vaultId := ""
+		if props := vault.BackupLongTermRetentionVaultProperties; props != nil && props.RecoveryServicesVaultResourceID != nil {
+			vaultId = *props.RecoveryServicesVaultResourceID
+		}
+		d.Set("long_term_retention_vault_id", vaultId)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -182,3 +261,30 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func expandAzureRmSqlServerIdentity(d *schema.ResourceData) *sql.ResourceIdentity {
+	identities := d.Get("identity").([]interface{})
+	identity := identities[0].(map[string]interface{})
+	identityType := identity["type"].(string)
+	return &sql.ResourceIdentity{
+		Type: sql.IdentityType(identityType),
+	}
+}
+
+func flattenAzureRmSqlServerIdentity(identity *sql.ResourceIdentity) []interface{} {
+	if identity == nil {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+	result["type"] = string(identity.Type)
+
+	if identity.PrincipalID != nil {
+		result["principal_id"] = identity.PrincipalID.String()
+	}
+	if identity.TenantID != nil {
+		result["tenant_id"] = identity.TenantID.String()
+	}
+
+	return []interface{}{result}
+}
@@ -132,6 +132,56 @@ func TestAccAzureRMSqlServer_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlServer_identity(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlServer_identity(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"administrator_login_password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServer_longTermRetentionVault(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlServer_longTermRetentionVault(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "long_term_retention_vault_id", "azurerm_recovery_services_vault.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSqlServerExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -279,3 +329,51 @@ resource "azurerm_sql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMSqlServer_identity(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+
+    identity {
+        type = "SystemAssigned"
+    }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSqlServer_longTermRetentionVault(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+    name = "acctest-%d"
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    sku = "Standard"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+    long_term_retention_vault_id = "${azurerm_recovery_services_vault.test.id}"
+}
+`, rInt, location, rInt, rInt)
+}
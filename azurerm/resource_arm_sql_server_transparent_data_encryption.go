@@ -0,0 +1,216 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the name of the Server Key used when Transparent Data Encryption is managed by the Service
+const sqlServerServiceManagedKeyName = "ServiceManaged"
+
+func resourceArmSqlServerTransparentDataEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Read:   resourceArmSqlServerTransparentDataEncryptionRead,
+		Update: resourceArmSqlServerTransparentDataEncryptionCreateUpdate,
+		Delete: resourceArmSqlServerTransparentDataEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.URLWithScheme([]string{"https"}),
+			},
+
+			"server_key_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSqlServerTransparentDataEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+
+	protector := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(sqlServerServiceManagedKeyName),
+			ServerKeyType: sql.ServiceManaged,
+		},
+	}
+
+	if keyVaultKeyId != "" {
+		keyName, err := sqlServerKeyNameFromKeyVaultKeyId(keyVaultKeyId)
+		if err != nil {
+			return err
+		}
+
+		// the Key Vault Key has to be registered as a Server Key before it can be used as the Encryption Protector
+		keysClient := meta.(*ArmClient).sqlServerKeysClient
+		key := sql.ServerKey{
+			ServerKeyProperties: &sql.ServerKeyProperties{
+				ServerKeyType: sql.AzureKeyVault,
+				URI:           utils.String(keyVaultKeyId),
+			},
+		}
+
+		log.Printf("[DEBUG] Adding Server Key %q to SQL Server %q (Resource Group %q)", keyName, serverName, resourceGroup)
+		keyFuture, err := keysClient.CreateOrUpdate(ctx, resourceGroup, serverName, keyName, key)
+		if err != nil {
+			return fmt.Errorf("Error adding Server Key %q to SQL Server %q (Resource Group %q): %+v", keyName, serverName, resourceGroup, err)
+		}
+
+		if err = keyFuture.WaitForCompletionRef(ctx, keysClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Server Key %q to be added to SQL Server %q (Resource Group %q): %+v", keyName, serverName, resourceGroup, err)
+		}
+
+		protector.EncryptionProtectorProperties.ServerKeyName = utils.String(keyName)
+		protector.EncryptionProtectorProperties.ServerKeyType = sql.AzureKeyVault
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, protector)
+	if err != nil {
+		return fmt.Errorf("Error setting the Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Encryption Protector for SQL Server %q (Resource Group %q) to be set: %+v", serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read the Encryption Protector for SQL Server %q (Resource Group %q) ID", serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSqlServerTransparentDataEncryptionRead(d, meta)
+}
+
+func resourceArmSqlServerTransparentDataEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Encryption Protector for SQL Server %q (Resource Group %q) was not found - removing from state", serverName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on the Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.EncryptionProtectorProperties; props != nil {
+		d.Set("server_key_type", string(props.ServerKeyType))
+		d.Set("thumbprint", props.Thumbprint)
+
+		keyVaultKeyId := ""
+		if props.ServerKeyType == sql.AzureKeyVault && props.URI != nil {
+			keyVaultKeyId = *props.URI
+		}
+		d.Set("key_vault_key_id", keyVaultKeyId)
+	}
+
+	return nil
+}
+
+func resourceArmSqlServerTransparentDataEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlEncryptionProtectorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	// the Encryption Protector can't be deleted, so instead we revert to the Service Managed key
+	protector := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(sqlServerServiceManagedKeyName),
+			ServerKeyType: sql.ServiceManaged,
+		},
+	}
+
+	log.Printf("[DEBUG] Reverting the Encryption Protector for SQL Server %q (Resource Group %q) to a Service Managed key", serverName, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, protector)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error reverting the Encryption Protector for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Encryption Protector for SQL Server %q (Resource Group %q) to be reverted: %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// sqlServerKeyNameFromKeyVaultKeyId returns the name of the Server Key for a Key Vault Key,
+// which must be in the format `{vaultName}_{keyName}_{keyVersion}`
+func sqlServerKeyNameFromKeyVaultKeyId(keyVaultKeyId string) (string, error) {
+	id, err := parseKeyVaultChildID(keyVaultKeyId)
+	if err != nil {
+		return "", err
+	}
+
+	baseUrl, err := url.Parse(id.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing the Key Vault URL %q: %+v", id.KeyVaultBaseUrl, err)
+	}
+
+	vaultName := strings.Split(baseUrl.Host, ".")[0]
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, id.Name, id.Version), nil
+}
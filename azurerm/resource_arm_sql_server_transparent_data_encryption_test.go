@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMSqlServerKeyNameFromKeyVaultKeyId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "https://my-vault.vault.azure.net/keys/my-key",
+			Error: true,
+		},
+		{
+			Input:    "https://my-vault.vault.azure.net/keys/my-key/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Expected: "my-vault_my-key_fdf067c93bbb4b22bff4d8b7a9a56217",
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := sqlServerKeyNameFromKeyVaultKeyId(tc.Input)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q for %q but got %q", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(t *testing.T) {
+	resourceName := "azurerm_sql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	config := testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionKeyType(resourceName, "ServiceManaged"),
+					resource.TestCheckResourceAttr(resourceName, "server_key_type", "ServiceManaged"),
+					resource.TestCheckResourceAttr(resourceName, "key_vault_key_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSqlServerTransparentDataEncryption_keyVault(t *testing.T) {
+	resourceName := "azurerm_sql_server_transparent_data_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(6)
+	location := testLocation()
+	preConfig := testAccAzureRMSqlServerTransparentDataEncryption_keyVault(ri, rs, location)
+	postConfig := testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionKeyType(resourceName, "AzureKeyVault"),
+					resource.TestCheckResourceAttr(resourceName, "server_key_type", "AzureKeyVault"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_vault_key_id", "azurerm_key_vault_key.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "thumbprint"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerTransparentDataEncryptionKeyType(resourceName, "ServiceManaged"),
+					resource.TestCheckResourceAttr(resourceName, "server_key_type", "ServiceManaged"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlServerTransparentDataEncryptionKeyType(name string, keyType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlEncryptionProtectorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on sqlEncryptionProtectorsClient: %+v", err)
+		}
+
+		if props := resp.EncryptionProtectorProperties; props == nil || string(props.ServerKeyType) != keyType {
+			return fmt.Errorf("Bad: the Encryption Protector for SQL Server %q (Resource Group %q) isn't of type %q", serverName, resourceGroup, keyType)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    key_permissions = [
+      "create",
+      "delete",
+      "get",
+      "update",
+    ]
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${azurerm_sql_server.test.identity.0.tenant_id}"
+  object_id           = "${azurerm_sql_server.test.identity.0.principal_id}"
+
+  key_permissions = [
+    "get",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name      = "key-%s"
+  vault_uri = "${azurerm_key_vault.test.vault_uri}"
+  key_type  = "RSA"
+  key_size  = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, rInt, location, rInt, rString, rString)
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_serviceManaged(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMSqlServerTransparentDataEncryption_keyVault(rInt int, rString string, location string) string {
+	template := testAccAzureRMSqlServerTransparentDataEncryption_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_transparent_data_encryption" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  server_name         = "${azurerm_sql_server.test.name}"
+  key_vault_key_id    = "${azurerm_key_vault_key.test.id}"
+
+  depends_on = ["azurerm_key_vault_access_policy.test"]
+}
+`, template)
+}
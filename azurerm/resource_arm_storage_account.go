@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -23,11 +25,30 @@ func resourceArmStorageAccount() *schema.Resource {
 		Delete: resourceArmStorageAccountDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmStorageAccountImportState,
 		},
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// BlobStorage accounts only expose the Blob service
+			if diff.Get("account_kind").(string) == string(storage.BlobStorage) {
+				for _, key := range []string{"queue_properties", "table_properties"} {
+					if _, ok := diff.GetOk(key); ok {
+						return fmt.Errorf("`%s` cannot be specified when `account_kind` is `%s`", key, string(storage.BlobStorage))
+					}
+				}
+			}
+
+			if _, ok := diff.GetOk("blob_properties.0.static_website"); ok && diff.NewValueKnown("account_kind") {
+				if kind := diff.Get("account_kind").(string); kind != string(storage.StorageV2) {
+					return fmt.Errorf("`static_website` can only be specified when `account_kind` is `%s` (got %q)", string(storage.StorageV2), kind)
+				}
+			}
+
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -262,6 +283,12 @@ func resourceArmStorageAccount() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"blob_properties": storageBlobServicePropertiesSchema(),
+
+			"queue_properties": storageServicePropertiesSchema(),
+
+			"table_properties": storageServicePropertiesSchema(),
+
 			"identity": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -416,6 +443,14 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	for _, service := range storageAccountServices {
+		if v, ok := d.GetOk(service.propertiesKey); ok {
+			if err := setStorageAccountServiceProperties(ctx, meta.(*ArmClient), resourceGroupName, storageAccountName, accountKind, service.name, v.([]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -585,6 +620,16 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("network_rules")
 	}
 
+	for _, service := range storageAccountServices {
+		if d.HasChange(service.propertiesKey) {
+			if err := setStorageAccountServiceProperties(ctx, meta.(*ArmClient), resourceGroupName, storageAccountName, accountKind, service.name, d.Get(service.propertiesKey).([]interface{})); err != nil {
+				return err
+			}
+
+			d.SetPartial(service.propertiesKey)
+		}
+	}
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
@@ -716,6 +761,28 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	// the Service Properties are retrieved from the Data Plane API, which may not be accessible (for example when the
+	// Storage Account is restricted to certain Virtual Networks) - as such they're only refreshed when they're configured
+	// (or have been imported, see `resourceArmStorageAccountImportState`)
+	for _, service := range storageAccountServices {
+		if _, ok := d.GetOk(service.propertiesKey); !ok {
+			continue
+		}
+
+		if !storageAccountSupportsService(resp, service.name) {
+			continue
+		}
+
+		properties, err := readStorageAccountServiceProperties(ctx, meta.(*ArmClient), resGroup, name, service.name)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set(service.propertiesKey, properties); err != nil {
+			return fmt.Errorf("Error flattening `%s`: %+v", service.propertiesKey, err)
+		}
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -740,6 +807,204 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceArmStorageAccountImportState retrieves the Service Properties for an imported Storage Account, which are
+// otherwise only refreshed when they're configured - failures are logged rather than failing the import
+func resourceArmStorageAccountImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).storageServiceClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	name := id.Path["storageAccounts"]
+	resGroup := id.ResourceGroup
+
+	resp, err := client.GetProperties(ctx, resGroup, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	for _, service := range storageAccountServices {
+		if !storageAccountSupportsService(resp, service.name) {
+			continue
+		}
+
+		properties, err := readStorageAccountServiceProperties(ctx, meta.(*ArmClient), resGroup, name, service.name)
+		if err != nil {
+			log.Printf("[WARN] Unable to retrieve the %s Service Properties for Storage Account %q (Resource Group %q) - skipping: %+v", service.name, name, resGroup, err)
+			continue
+		}
+
+		if err := d.Set(service.propertiesKey, properties); err != nil {
+			return nil, fmt.Errorf("Error flattening `%s`: %+v", service.propertiesKey, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type storageAccountService struct {
+	name          string
+	propertiesKey string
+}
+
+var storageAccountServices = []storageAccountService{
+	{name: "blob", propertiesKey: "blob_properties"},
+	{name: "queue", propertiesKey: "queue_properties"},
+	{name: "table", propertiesKey: "table_properties"},
+}
+
+// storageAccountSupportsService returns whether the Queue & Table services are available, since
+// BlobStorage and Premium Storage Accounts only expose the Blob service
+func storageAccountSupportsService(account storage.Account, service string) bool {
+	if service == "blob" {
+		return true
+	}
+
+	if account.Kind == storage.BlobStorage {
+		return false
+	}
+
+	if sku := account.Sku; sku != nil && sku.Tier == storage.Premium {
+		return false
+	}
+
+	return true
+}
+
+func readStorageAccountServiceProperties(ctx context.Context, client *ArmClient, resourceGroup, storageAccountName, service string) ([]interface{}, error) {
+	properties, err := getStorageAccountServiceProperties(ctx, client, resourceGroup, storageAccountName, service)
+	if err != nil {
+		return nil, err
+	}
+
+	output := flattenStorageServiceProperties(properties)
+	if service != "blob" {
+		return output, nil
+	}
+
+	sharedKeyClient, accountExists, err := client.getSharedKeyClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return nil, err
+	}
+	if !accountExists {
+		return nil, fmt.Errorf("Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	blobProperties, err := sharedKeyClient.GetBlobServiceProperties(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the blob Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	return flattenStorageBlobServiceProperties(output, blobProperties), nil
+}
+
+func getStorageAccountServiceProperties(ctx context.Context, client *ArmClient, resourceGroup, storageAccountName, service string) (*mainStorage.ServiceProperties, error) {
+	var properties *mainStorage.ServiceProperties
+	var accountExists bool
+	var err error
+
+	switch service {
+	case "blob":
+		var blobClient *mainStorage.BlobStorageClient
+		blobClient, accountExists, err = client.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err == nil && accountExists {
+			properties, err = blobClient.GetServiceProperties()
+		}
+	case "queue":
+		var queueClient *mainStorage.QueueServiceClient
+		queueClient, accountExists, err = client.getQueueServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err == nil && accountExists {
+			properties, err = queueClient.GetServiceProperties()
+		}
+	case "table":
+		var tableClient *mainStorage.TableServiceClient
+		tableClient, accountExists, err = client.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err == nil && accountExists {
+			properties, err = tableClient.GetServiceProperties()
+		}
+	default:
+		return nil, fmt.Errorf("Unsupported Storage Service %q", service)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the %s Service Properties for Storage Account %q (Resource Group %q): %+v", service, storageAccountName, resourceGroup, err)
+	}
+	if !accountExists {
+		return nil, fmt.Errorf("Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	return properties, nil
+}
+
+func setStorageAccountServiceProperties(ctx context.Context, client *ArmClient, resourceGroup, storageAccountName, accountKind, service string, input []interface{}) error {
+	properties := expandStorageServiceProperties(input)
+
+	var accountExists bool
+	var err error
+
+	log.Printf("[DEBUG] Updating the %s Service Properties for Storage Account %q (Resource Group %q)..", service, storageAccountName, resourceGroup)
+	switch service {
+	case "blob":
+		var blobClient *mainStorage.BlobStorageClient
+		blobClient, accountExists, err = client.getBlobStorageClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err == nil && accountExists {
+			err = blobClient.SetServiceProperties(properties)
+		}
+	case "queue":
+		var queueClient *mainStorage.QueueServiceClient
+		queueClient, accountExists, err = client.getQueueServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err == nil && accountExists {
+			err = queueClient.SetServiceProperties(properties)
+		}
+	case "table":
+		var tableClient *mainStorage.TableServiceClient
+		tableClient, accountExists, err = client.getTableServiceClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+		if err == nil && accountExists {
+			err = tableClient.SetServiceProperties(properties)
+		}
+	default:
+		return fmt.Errorf("Unsupported Storage Service %q", service)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error updating the %s Service Properties for Storage Account %q (Resource Group %q): %+v", service, storageAccountName, resourceGroup, err)
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	if service == "blob" {
+		return setStorageAccountBlobServiceProperties(ctx, client, resourceGroup, storageAccountName, accountKind, input)
+	}
+
+	return nil
+}
+
+func setStorageAccountBlobServiceProperties(ctx context.Context, client *ArmClient, resourceGroup, storageAccountName, accountKind string, input []interface{}) error {
+	properties := expandStorageBlobServiceProperties(input)
+
+	// Static Website hosting is only available for StorageV2 accounts
+	if accountKind != string(storage.StorageV2) {
+		properties.StaticWebsite = nil
+	}
+
+	sharedKeyClient, accountExists, err := client.getSharedKeyClientForStorageAccount(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q (Resource Group %q) was not found", storageAccountName, resourceGroup)
+	}
+
+	if err := sharedKeyClient.SetBlobServiceProperties(ctx, properties); err != nil {
+		return fmt.Errorf("Error updating the blob Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func expandStorageAccountCustomDomain(d *schema.ResourceData) *storage.CustomDomain {
 	domains := d.Get("custom_domain").([]interface{})
 	if domains == nil || len(domains) == 0 {
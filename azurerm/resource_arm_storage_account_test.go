@@ -475,6 +475,91 @@ func TestAccAzureRMStorageAccount_networkRulesDeleted(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageAccount_serviceProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMStorageAccount_serviceProperties(ri, rs, location)
+	postConfig := testAccAzureRMStorageAccount_servicePropertiesUpdated(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.0.max_age_in_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.0.delete", "true"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.0.retention_policy_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.hour_metrics.0.include_apis", "true"),
+					resource.TestCheckResourceAttr(resourceName, "table_properties.0.minute_metrics.0.retention_policy_days", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.cors_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "queue_properties.0.hour_metrics.0.include_apis", "false"),
+					resource.TestCheckResourceAttr(resourceName, "table_properties.0.minute_metrics.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccount_blobServiceProperties(t *testing.T) {
+	resourceName := "azurerm_storage_account.testsa"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+	preConfig := testAccAzureRMStorageAccount_blobServiceProperties(ri, rs, location)
+	postConfig := testAccAzureRMStorageAccount_blobServicePropertiesDisabled(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.default_service_version", "2018-03-28"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.delete_retention_policy.0.days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.static_website.0.index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.static_website.0.error_404_document", "404.html"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.delete_retention_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blob_properties.0.static_website.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageAccountExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -968,3 +1053,149 @@ resource "azurerm_storage_account" "testsa" {
 }
 `, rInt, location, rInt, rInt, rString)
 }
+
+func testAccAzureRMStorageAccount_serviceProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "testAccAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      allowed_methods    = ["GET", "PUT"]
+      allowed_headers    = ["x-tempo-*"]
+      exposed_headers    = ["x-tempo-*"]
+      max_age_in_seconds = 3600
+    }
+  }
+
+  queue_properties {
+    logging {
+      version               = "1.0"
+      delete                = true
+      read                  = true
+      write                 = true
+      retention_policy_days = 7
+    }
+
+    hour_metrics {
+      version               = "1.0"
+      include_apis          = true
+      retention_policy_days = 7
+    }
+  }
+
+  table_properties {
+    minute_metrics {
+      version               = "1.0"
+      include_apis          = false
+      retention_policy_days = 10
+    }
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_servicePropertiesUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "testAccAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    cors_rule {
+      allowed_origins    = ["http://www.example.com"]
+      allowed_methods    = ["GET", "PUT"]
+      allowed_headers    = ["x-tempo-*"]
+      exposed_headers    = ["x-tempo-*"]
+      max_age_in_seconds = 3600
+    }
+
+    cors_rule {
+      allowed_origins    = ["http://www.example.org"]
+      allowed_methods    = ["GET"]
+      max_age_in_seconds = 60
+    }
+  }
+
+  queue_properties {
+    hour_metrics {
+      version      = "1.0"
+      include_apis = false
+    }
+  }
+
+  table_properties {}
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_blobServiceProperties(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "testAccAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    default_service_version = "2018-03-28"
+
+    delete_retention_policy {
+      days = 7
+    }
+
+    static_website {
+      index_document     = "index.html"
+      error_404_document = "404.html"
+    }
+  }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageAccount_blobServicePropertiesDisabled(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "testrg" {
+  name     = "testAccAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "testsa" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.testrg.name}"
+  location                 = "${azurerm_resource_group.testrg.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    default_service_version = "2018-03-28"
+  }
+}
+`, rInt, location, rString)
+}
@@ -2,13 +2,16 @@ package azurerm
 
 import (
 	"bytes"
+	"crypto/md5"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"net/url"
 	"os"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
@@ -16,6 +19,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -29,6 +33,7 @@ func resourceArmStorageBlob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceArmStorageBlobCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -69,14 +74,41 @@ func resourceArmStorageBlob() *schema.Resource {
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
+			},
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "source_uri"},
 			},
 			"source_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_content"},
+			},
+			"content_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArmStorageBlobMetadata,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Hot",
+					"Cool",
+					"Archive",
+				}, false),
 			},
 			"url": {
 				Type:     schema.TypeString,
@@ -104,7 +136,7 @@ func validateArmStorageBlobParallelism(v interface{}, k string) (ws []string, er
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Parallelism %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Parallelism %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -114,7 +146,7 @@ func validateArmStorageBlobAttempts(v interface{}, k string) (ws []string, error
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Attempts %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Attempts %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -124,7 +156,7 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 	value := v.(int)
 
 	if value%512 != 0 {
-		errors = append(errors, fmt.Errorf("Blob Size %q is invalid, must be a multiple of 512", value))
+		errors = append(errors, fmt.Errorf("Blob Size %d is invalid, must be a multiple of 512", value))
 	}
 
 	return
@@ -133,16 +165,89 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 func validateArmStorageBlobType(v interface{}, k string) (ws []string, errors []error) {
 	value := strings.ToLower(v.(string))
 	validTypes := map[string]struct{}{
-		"block": {},
-		"page":  {},
+		"append": {},
+		"block":  {},
+		"page":   {},
 	}
 
 	if _, ok := validTypes[value]; !ok {
-		errors = append(errors, fmt.Errorf("Blob type %q is invalid, must be %q or %q", value, "block", "page"))
+		errors = append(errors, fmt.Errorf("Blob type %q is invalid, must be %q, %q or %q", value, "append", "block", "page"))
 	}
 	return
 }
 
+func validateArmStorageBlobMetadata(v interface{}, k string) (ws []string, errors []error) {
+	metadata := v.(map[string]interface{})
+
+	// the keys are returned in lower-case by the API, so we require they're specified in lower-case to avoid a diff
+	for key := range metadata {
+		if !regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString(key) {
+			errors = append(errors, fmt.Errorf("Blob Metadata key %q is invalid, must start with a lower-case letter or underscore and only contain lower-case letters, numbers and underscores", key))
+		}
+	}
+
+	return
+}
+
+func resourceArmStorageBlobCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	blobType := strings.ToLower(diff.Get("type").(string))
+
+	if blobType == "" && diff.Get("source_uri").(string) == "" {
+		return fmt.Errorf("`type` must be specified when `source_uri` isn't set")
+	}
+
+	if _, ok := diff.GetOk("source_content"); ok && blobType == "page" {
+		return fmt.Errorf("`source_content` cannot be used with Page Blobs")
+	}
+
+	if tier := diff.Get("access_tier").(string); diff.HasChange("access_tier") && tier != "" && blobType != "block" {
+		return fmt.Errorf("`access_tier` can only be set for Block Blobs")
+	}
+
+	// Page Blobs are typically Disk Images which are written to in-place, so re-uploading them would wipe the disk - as
+	// such changing the source file recreates the Blob (as it always has) and changes to the contents are ignored
+	if blobType == "page" {
+		if diff.Id() != "" && diff.HasChange("source") {
+			if err := diff.ForceNew("source"); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// when the source changes the contents will be re-uploaded - and the Content MD5 recalculated
+	if diff.HasChange("source") || diff.HasChange("source_content") {
+		if err := diff.SetNewComputed("content_md5"); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	// the contents can only be compared once the Content MD5 is known, which isn't the case for new Blobs
+	// nor for Blobs uploaded without a Content MD5 (for example by earlier versions of this resource)
+	existingMD5 := diff.Get("content_md5").(string)
+	if diff.Id() == "" || existingMD5 == "" {
+		return nil
+	}
+
+	// calculating the Content MD5 of the source allows us to detect when the contents of the file have changed
+	contentMD5, err := resourceArmStorageBlobComputeContentMD5(diff.Get("source").(string), diff.Get("source_content").(string))
+	if err != nil {
+		// the source file may not exist yet (for example when it's generated during the apply)
+		log.Printf("[DEBUG] Unable to calculate the Content MD5 of the Blob source: %+v", err)
+		return nil
+	}
+
+	if contentMD5 != "" && contentMD5 != existingMD5 {
+		if err := diff.SetNew("content_md5", contentMD5); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -160,10 +265,8 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	name := d.Get("name").(string)
-	blobType := d.Get("type").(string)
 	containerName := d.Get("storage_container_name").(string)
 	sourceUri := d.Get("source_uri").(string)
-	contentType := d.Get("content_type").(string)
 
 	log.Printf("[INFO] Creating blob %q in container %q within storage account %q", name, containerName, storageAccountName)
 	container := blobClient.GetContainerReference(containerName)
@@ -175,51 +278,220 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 		}
+
+		if v, ok := d.GetOk("metadata"); ok {
+			blob.Metadata = expandStorageBlobMetadata(v.(map[string]interface{}))
+			if err := blob.SetMetadata(&storage.SetBlobMetadataOptions{}); err != nil {
+				return fmt.Errorf("Error setting metadata of storage blob on Azure: %s", err)
+			}
+		}
 	} else {
-		switch strings.ToLower(blobType) {
-		case "block":
-			options := &storage.PutBlobOptions{}
-			err := blob.CreateBlockBlob(options)
+		if err := resourceArmStorageBlobUpload(d, blobClient, containerName, name); err != nil {
+			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+		}
+	}
+
+	if tier := d.Get("access_tier").(string); tier != "" {
+		sharedKeyClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		if err := sharedKeyClient.SetBlobAccessTier(ctx, containerName, name, tier); err != nil {
+			return err
+		}
+	}
+
+	// gives us https://example.blob.core.windows.net/container/file.vhd
+	id := fmt.Sprintf("https://%s.blob.%s/%s/%s", storageAccountName, env.StorageEndpointSuffix, containerName, name)
+	d.SetId(id)
+	return resourceArmStorageBlobRead(d, meta)
+}
+
+// resourceArmStorageBlobUpload (re-)creates the Blob from either the `source` file or the `source_content`
+// and then sets the Content Type, Content MD5 and Metadata - since these are replaced when uploading
+func resourceArmStorageBlobUpload(d *schema.ResourceData, client *storage.BlobStorageClient, containerName, name string) error {
+	blobType := strings.ToLower(d.Get("type").(string))
+	source := d.Get("source").(string)
+	sourceContent := d.Get("source_content").(string)
+	contentType := d.Get("content_type").(string)
+	parallelism := d.Get("parallelism").(int)
+	attempts := d.Get("attempts").(int)
+
+	container := client.GetContainerReference(containerName)
+	blob := container.GetBlobReference(name)
+
+	switch blobType {
+	case "append":
+		blob.Properties.ContentType = contentType
+		if err := blob.PutAppendBlob(&storage.PutBlobOptions{}); err != nil {
+			return err
+		}
+
+		if source != "" {
+			file, err := os.Open(source)
 			if err != nil {
-				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				return fmt.Errorf("Error opening source file for upload %q: %s", source, err)
 			}
+			defer file.Close()
 
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
+			if err := resourceArmStorageBlobAppendFromReader(blob, file, attempts); err != nil {
+				return fmt.Errorf("Error while uploading source file %q: %s", source, err)
+			}
+		} else if sourceContent != "" {
+			if err := resourceArmStorageBlobAppendFromReader(blob, strings.NewReader(sourceContent), attempts); err != nil {
+				return fmt.Errorf("Error while uploading source content: %s", err)
+			}
+		}
+	case "block":
+		if source != "" {
+			if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, client, parallelism, attempts); err != nil {
+				return err
+			}
+		} else {
+			blob.Properties.ContentType = contentType
+			if err := blob.CreateBlockBlobFromReader(strings.NewReader(sourceContent), &storage.PutBlobOptions{}); err != nil {
+				return err
+			}
+		}
+	case "page":
+		if source != "" {
+			if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, client, parallelism, attempts); err != nil {
+				return err
+			}
+		} else {
+			size := int64(d.Get("size").(int))
+			options := &storage.PutBlobOptions{}
 
-				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
+			blob.Properties.ContentLength = size
+			blob.Properties.ContentType = contentType
+			if err := blob.PutPageBlob(options); err != nil {
+				return err
 			}
-		case "page":
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
-
-				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
-			} else {
-				size := int64(d.Get("size").(int))
-				options := &storage.PutBlobOptions{}
+		}
+	}
 
-				blob.Properties.ContentLength = size
-				blob.Properties.ContentType = contentType
-				err := blob.PutPageBlob(options)
-				if err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+	contentMD5, err := resourceArmStorageBlobComputeContentMD5(source, sourceContent)
+	if err != nil {
+		return err
+	}
+
+	if err := resourceArmStorageBlobSetProperties(blob, contentType, contentMD5); err != nil {
+		return err
+	}
+
+	blob.Metadata = expandStorageBlobMetadata(d.Get("metadata").(map[string]interface{}))
+	if err := blob.SetMetadata(&storage.SetBlobMetadataOptions{}); err != nil {
+		return fmt.Errorf("Error setting metadata: %s", err)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobComputeContentMD5 returns the hex-encoded MD5 of either the source file or the inline content
+func resourceArmStorageBlobComputeContentMD5(source, sourceContent string) (string, error) {
+	hasher := md5.New()
+
+	if source != "" {
+		file, err := os.Open(source)
+		if err != nil {
+			return "", fmt.Errorf("Error opening source file %q: %s", source, err)
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(hasher, file); err != nil {
+			return "", fmt.Errorf("Error reading source file %q: %s", source, err)
+		}
+	} else if sourceContent != "" {
+		hasher.Write([]byte(sourceContent))
+	} else {
+		return "", nil
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// resourceArmStorageBlobSetProperties sets the Content Type and Content MD5 of the Blob - retaining the other properties,
+// which would otherwise be cleared by the API
+func resourceArmStorageBlobSetProperties(blob *storage.Blob, contentType, contentMD5 string) error {
+	if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error getting properties: %s", err)
+	}
+
+	decodedMD5, err := hex.DecodeString(contentMD5)
+	if err != nil {
+		return fmt.Errorf("Error decoding Content MD5 %q: %s", contentMD5, err)
+	}
+
+	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = ""
+	if len(decodedMD5) > 0 {
+		blob.Properties.ContentMD5 = base64.StdEncoding.EncodeToString(decodedMD5)
+	}
+
+	if err := blob.SetProperties(&storage.SetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error setting properties: %s", err)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobAppendFromReader appends the contents of the reader to the Append Blob in chunks of 4MB,
+// which is the maximum size of an Append Block
+func resourceArmStorageBlobAppendFromReader(blob *storage.Blob, reader io.Reader, attempts int) error {
+	const blockSize = 4 * 1024 * 1024
+
+	buffer := make([]byte, blockSize)
+	position := uint(0)
+	for {
+		n, readErr := io.ReadFull(reader, buffer)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return fmt.Errorf("Error reading at offset %d: %s", position, readErr)
+		}
+
+		if n > 0 {
+			var err error
+			for i := 0; i < attempts; i++ {
+				// specifying the position ensures a retried block can't be appended twice
+				appendPosition := position
+				options := &storage.AppendBlockOptions{
+					AppendPosition: &appendPosition,
 				}
+				err = blob.AppendBlock(buffer[:n], options)
+				if err == nil {
+					break
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("Error appending block at offset %d: %s", position, err)
 			}
+
+			position += uint(n)
+		}
+
+		if readErr != nil {
+			return nil
 		}
 	}
+}
 
-	// gives us https://example.blob.core.windows.net/container/file.vhd
-	id := fmt.Sprintf("https://%s.blob.%s/%s/%s", storageAccountName, env.StorageEndpointSuffix, containerName, name)
-	d.SetId(id)
-	return resourceArmStorageBlobRead(d, meta)
+func expandStorageBlobMetadata(input map[string]interface{}) storage.BlobMetadata {
+	output := make(storage.BlobMetadata)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func flattenStorageBlobMetadata(input storage.BlobMetadata) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
 }
 
 type resourceArmStorageBlobPage struct {
@@ -572,17 +844,55 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	container := blobClient.GetContainerReference(id.containerName)
 	blob := container.GetBlobReference(id.blobName)
 
-	if d.HasChange("content_type") {
-		blob.Properties.ContentType = d.Get("content_type").(string)
+	// Page Blobs are never re-uploaded in-place, since changing their source recreates them
+	reupload := d.HasChange("source") || d.HasChange("source_content") || d.HasChange("content_md5")
+	reupload = reupload && strings.ToLower(d.Get("type").(string)) != "page"
+	if reupload {
+		log.Printf("[INFO] Re-uploading the contents of blob %s (container %s, storage account %s)", id.blobName, id.containerName, id.storageAccountName)
+		if err := resourceArmStorageBlobUpload(d, blobClient, id.containerName, id.blobName); err != nil {
+			return fmt.Errorf("Error uploading blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
+	} else {
+		if d.HasChange("content_type") {
+			if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+				return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+
+			blob.Properties.ContentType = d.Get("content_type").(string)
+
+			options := &storage.SetBlobPropertiesOptions{}
+			err = blob.SetProperties(options)
+			if err != nil {
+				return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+		}
+
+		if d.HasChange("metadata") {
+			blob.Metadata = expandStorageBlobMetadata(d.Get("metadata").(map[string]interface{}))
+			if err := blob.SetMetadata(&storage.SetBlobMetadataOptions{}); err != nil {
+				return fmt.Errorf("Error setting metadata of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+		}
 	}
 
-	options := &storage.SetBlobPropertiesOptions{}
-	err = blob.SetProperties(options)
-	if err != nil {
-		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	// re-uploading a Blob resets its Access Tier to the Storage Account's default, so the configured tier is re-applied
+	if d.HasChange("access_tier") || reupload {
+		if tier := d.Get("access_tier").(string); tier != "" {
+			sharedKeyClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+			if err != nil {
+				return err
+			}
+			if !accountExists {
+				return fmt.Errorf("Storage account %s not found in resource group %s", id.storageAccountName, *resourceGroup)
+			}
+
+			if err := sharedKeyClient.SetBlobAccessTier(ctx, id.containerName, id.blobName, tier); err != nil {
+				return err
+			}
+		}
 	}
 
-	return nil
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
@@ -645,6 +955,41 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	blobType := strings.ToLower(strings.Replace(string(blob.Properties.BlobType), "Blob", "", 1))
 	d.Set("type", blobType)
 
+	// the API returns the Content MD5 base64 encoded, however it's exposed hex encoded for consistency with `md5()`
+	contentMD5 := ""
+	if blob.Properties.ContentMD5 != "" {
+		decodedMD5, err := base64.StdEncoding.DecodeString(blob.Properties.ContentMD5)
+		if err != nil {
+			return fmt.Errorf("Error decoding Content MD5 %q of blob %s: %+v", blob.Properties.ContentMD5, id.blobName, err)
+		}
+		contentMD5 = hex.EncodeToString(decodedMD5)
+	}
+	d.Set("content_md5", contentMD5)
+
+	if err := d.Set("metadata", flattenStorageBlobMetadata(blob.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
+
+	// Access Tiers are only supported for Block Blobs
+	accessTier := ""
+	if blob.Properties.BlobType == storage.BlobTypeBlock {
+		sharedKeyClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			log.Printf("[DEBUG] Storage account %q not found, removing blob %q from state", id.storageAccountName, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		accessTier, err = sharedKeyClient.GetBlobAccessTier(ctx, id.containerName, id.blobName)
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("access_tier", accessTier)
+
 	url := blob.GetURL()
 	if url == "" {
 		log.Printf("[INFO] URL for %q is empty", id.blobName)
@@ -1,7 +1,9 @@
 package azurerm
 
 import (
+	"crypto/md5"
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -28,6 +30,10 @@ func TestResourceAzureRMStorageBlobType_validation(t *testing.T) {
 			Value:    "page",
 			ErrCount: 0,
 		},
+		{
+			Value:    "append",
+			ErrCount: 0,
+		},
 		{
 			Value:    "block",
 			ErrCount: 0,
@@ -143,6 +149,38 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMStorageBlobMetadata_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"hello": "world", "_private": "value", "key_2": "value"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"Hello": "world"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"2key": "value", "hello-world": "value"},
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobMetadata(tc.Value, "metadata")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %+v but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := acctest.RandInt()
@@ -284,6 +322,16 @@ func TestAccAzureRMStorageBlobPage_source(t *testing.T) {
 					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypePage, sourceBlob.Name()),
 				),
 			},
+			{
+				// changes to the contents of a Page Blob's source mustn't re-upload (and wipe) the Blob
+				PreConfig: func() {
+					if err := ioutil.WriteFile(sourceBlob.Name(), []byte("changed"), 0644); err != nil {
+						t.Fatalf("Failed to write to source blob: %+v", err)
+					}
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -375,6 +423,182 @@ func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceChanged(t *testing.T) {
+	resourceName := "azurerm_storage_blob.source"
+	ri := acctest.RandInt()
+	rs1 := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := sourceBlob.Close(); err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	writeSource := func(contents string) func() {
+		return func() {
+			if err := ioutil.WriteFile(sourceBlob.Name(), []byte(contents), 0644); err != nil {
+				t.Fatalf("Failed to write to source blob: %+v", err)
+			}
+		}
+	}
+
+	config := testAccAzureRMStorageBlobBlock_source(ri, rs1, sourceBlob.Name(), testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: writeSource("first"),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", testStorageBlobContentMD5("first")),
+				),
+			},
+			{
+				PreConfig: writeSource("second"),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", testStorageBlobContentMD5("second")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_sourceContent(ri, rs, location, "block", "Hello World"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", testStorageBlobContentMD5("Hello World")),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlob_sourceContent(ri, rs, location, "block", "Goodbye World"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", testStorageBlobContentMD5("Goodbye World")),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobAppend_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	config := testAccAzureRMStorageBlob_sourceContent(ri, rs, testLocation(), "append", "Hello World")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "type", "append"),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", testStorageBlobContentMD5("Hello World")),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlob_metadata(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_metadata(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "world"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlob_metadataUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.hello", "earth"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_accessTier(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_accessTier(ri, rs, location, "Cool"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Cool"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlobBlock_accessTier(ri, rs, location, "Hot"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Hot"),
+				),
+			},
+		},
+	})
+}
+
+func testStorageBlobContentMD5(contents string) string {
+	hash := md5.Sum([]byte(contents))
+	return hex.EncodeToString(hash[:])
+}
+
 func testCheckAzureRMStorageBlobExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -770,3 +994,107 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlob_template(rInt int, rString, location, accountKind string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "%s"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+`, rInt, location, rString, accountKind)
+}
+
+func testAccAzureRMStorageBlob_sourceContent(rInt int, rString, location, blobType, content string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "Storage")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "%s"
+  content_type           = "text/plain"
+  source_content         = "%s"
+
+  metadata {
+    hello = "world"
+  }
+}
+`, template, blobType, content)
+}
+
+func testAccAzureRMStorageBlob_metadata(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "Storage")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "page"
+  size                   = 5120
+
+  metadata {
+    hello = "world"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageBlob_metadataUpdated(rInt int, rString, location string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "Storage")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "page"
+  size                   = 5120
+
+  metadata {
+    hello       = "earth"
+    environment = "test"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageBlobBlock_accessTier(rInt int, rString, location, accessTier string) string {
+	template := testAccAzureRMStorageBlob_template(rInt, rString, location, "StorageV2")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "Hello World"
+  access_tier            = "%s"
+}
+`, template, accessTier)
+}
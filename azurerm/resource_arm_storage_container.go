@@ -18,6 +18,7 @@ func resourceArmStorageContainer() *schema.Resource {
 	return &schema.Resource{
 		Create:        resourceArmStorageContainerCreate,
 		Read:          resourceArmStorageContainerRead,
+		Update:        resourceArmStorageContainerUpdate,
 		Delete:        resourceArmStorageContainerDelete,
 		MigrateState:  resourceStorageContainerMigrateState,
 		SchemaVersion: 1,
@@ -46,6 +47,8 @@ func resourceArmStorageContainer() *schema.Resource {
 				ValidateFunc: validateArmStorageContainerAccessType,
 			},
 
+			"acl": storageACLSchema("rwdl"),
+
 			"properties": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -103,11 +106,10 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
 	}
 
-	var accessType storage.ContainerAccessType
-	if d.Get("container_access_type").(string) == "private" {
-		accessType = storage.ContainerAccessType("")
-	} else {
-		accessType = storage.ContainerAccessType(d.Get("container_access_type").(string))
+	accessType := expandStorageContainerAccessType(d.Get("container_access_type").(string))
+	accessPolicies, err := expandStorageACL(d.Get("acl").([]interface{}))
+	if err != nil {
+		return err
 	}
 
 	log.Printf("[INFO] Creating container %q in storage account %q.", name, storageAccountName)
@@ -118,12 +120,15 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating container %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
-	permissions := storage.ContainerPermissions{
-		AccessType: accessType,
-	}
-	permissionOptions := &storage.SetContainerPermissionOptions{}
-	err = reference.SetPermissions(permissions, permissionOptions)
+	aclClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	if err := aclClient.SetContainerACL(ctx, name, accessType, accessPolicies); err != nil {
 		return fmt.Errorf("Error setting permissions for container %s in storage account %s: %+v", name, storageAccountName, err)
 	}
 
@@ -132,6 +137,42 @@ func resourceArmStorageContainerCreate(d *schema.ResourceData, meta interface{})
 	return resourceArmStorageContainerRead(d, meta)
 }
 
+func resourceArmStorageContainerUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageContainerID(d.Id(), armClient.environment)
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	aclClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("acl") {
+		accessPolicies, err := expandStorageACL(d.Get("acl").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		// the Access Type is set alongside the Access Policies, so we need to send it too
+		accessType := expandStorageContainerAccessType(d.Get("container_access_type").(string))
+
+		log.Printf("[INFO] Updating the ACL for container %q in storage account %q.", id.containerName, id.storageAccountName)
+		if err := aclClient.SetContainerACL(ctx, id.containerName, accessType, accessPolicies); err != nil {
+			return fmt.Errorf("Error updating the ACL for container %q in storage account %q: %+v", id.containerName, id.storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageContainerRead(d, meta)
+}
+
 // resourceAzureStorageContainerRead does all the necessary API calls to
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
@@ -207,6 +248,25 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error flattening `properties`: %+v", err)
 	}
 
+	aclClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, *resourceGroup, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing container %q from state", id.storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	accessPolicies, err := aclClient.GetContainerACL(ctx, id.containerName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ACL for container %q in storage account %q: %+v", id.containerName, id.storageAccountName, err)
+	}
+
+	if err := d.Set("acl", flattenStorageACL(accessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `acl`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -261,6 +321,15 @@ func checkContainerIsCreated(reference *storage.Container) func() *resource.Retr
 	}
 }
 
+func expandStorageContainerAccessType(input string) storage.ContainerAccessType {
+	// for historical reasons, "private" is an empty string in the API
+	if input == "private" {
+		return storage.ContainerAccessTypePrivate
+	}
+
+	return storage.ContainerAccessType(input)
+}
+
 type storageContainerId struct {
 	storageAccountName string
 	containerName      string
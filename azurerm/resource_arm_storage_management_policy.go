@@ -0,0 +1,504 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-07-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateOrUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateOrUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmStorageManagementPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStorageManagementPolicyRuleName,
+						},
+
+						"filters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										Set: schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"blockBlob",
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// the SDK models the Management Policy as a generic JSON object, so the Rules are (de)serialized through these types
+type storageManagementPolicySchema struct {
+	Version *string                        `json:"version,omitempty"`
+	Rules   *[]storageManagementPolicyRule `json:"rules,omitempty"`
+}
+
+type storageManagementPolicyRule struct {
+	Name       *string                            `json:"name,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+	Definition *storageManagementPolicyDefinition `json:"definition,omitempty"`
+}
+
+type storageManagementPolicyDefinition struct {
+	Actions *storageManagementPolicyActionSet `json:"actions,omitempty"`
+	Filters *storageManagementPolicyFilter    `json:"filters,omitempty"`
+}
+
+type storageManagementPolicyFilter struct {
+	PrefixMatch *[]string `json:"prefixMatch,omitempty"`
+	BlobTypes   *[]string `json:"blobTypes,omitempty"`
+}
+
+type storageManagementPolicyActionSet struct {
+	BaseBlob *storageManagementPolicyBaseBlob `json:"baseBlob,omitempty"`
+	Snapshot *storageManagementPolicySnapShot `json:"snapshot,omitempty"`
+}
+
+type storageManagementPolicyBaseBlob struct {
+	TierToCool    *storageDateAfterModification `json:"tierToCool,omitempty"`
+	TierToArchive *storageDateAfterModification `json:"tierToArchive,omitempty"`
+	Delete        *storageDateAfterModification `json:"delete,omitempty"`
+}
+
+type storageManagementPolicySnapShot struct {
+	Delete *storageDateAfterCreation `json:"delete,omitempty"`
+}
+
+type storageDateAfterModification struct {
+	DaysAfterModificationGreaterThan *float64 `json:"daysAfterModificationGreaterThan,omitempty"`
+}
+
+type storageDateAfterCreation struct {
+	DaysAfterCreationGreaterThan *float64 `json:"daysAfterCreationGreaterThan,omitempty"`
+}
+
+func resourceArmStorageManagementPolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	rules := diff.Get("rule").([]interface{})
+
+	names := make(map[string]bool)
+	for i, r := range rules {
+		rule := r.(map[string]interface{})
+		name := rule["name"].(string)
+
+		if diff.NewValueKnown(fmt.Sprintf("rule.%d.name", i)) {
+			if names[name] {
+				return fmt.Errorf("Rule names must be unique - the rule %q is defined more than once", name)
+			}
+			names[name] = true
+		}
+
+		actions := rule["actions"].([]interface{})
+		if len(actions) == 0 || actions[0] == nil {
+			continue
+		}
+		action := actions[0].(map[string]interface{})
+
+		if v := action["base_blob"].([]interface{}); len(v) > 0 && v[0] != nil {
+			hasAction := false
+			for _, field := range []string{
+				"tier_to_cool_after_days_since_modification_greater_than",
+				"tier_to_archive_after_days_since_modification_greater_than",
+				"delete_after_days_since_modification_greater_than",
+			} {
+				key := fmt.Sprintf("rule.%d.actions.0.base_blob.0.%s", i, field)
+				if _, ok := diff.GetOk(key); ok || !diff.NewValueKnown(key) {
+					hasAction = true
+				}
+			}
+
+			if !hasAction {
+				return fmt.Errorf("Rule %q: at least one action must be specified within the `base_blob` block", name)
+			}
+		} else if v := action["snapshot"].([]interface{}); len(v) == 0 || v[0] == nil {
+			return fmt.Errorf("Rule %q: at least one of `base_blob` or `snapshot` must be specified within the `actions` block", name)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := parseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	rules := expandStorageManagementPolicyRules(d.Get("rule").([]interface{}))
+	parameters := storage.ManagementPoliciesRulesSetParameter{
+		ManagementPoliciesRules: &storage.ManagementPoliciesRules{
+			Policy: storageManagementPolicySchema{
+				Version: utils.String("0.5"),
+				Rules:   rules,
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating/updating the Management Policy for Storage Account %q (Resource Group %q)..", storageAccountName, resourceGroup)
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, storageAccountName, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating the Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of the Management Policy for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.Get(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy for Storage Account %q (Resource Group %q) was not found - removing from state", storageAccountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving the Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+	}
+
+	storageAccountId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s", id.SubscriptionID, resourceGroup, storageAccountName)
+	d.Set("storage_account_id", storageAccountId)
+
+	var rules []interface{}
+	if props := resp.AccountManagementPoliciesRulesProperty; props != nil && props.Policy != nil {
+		// the Policy is returned as a generic JSON object, so we round-trip it into the typed struct
+		policyJson, err := json.Marshal(props.Policy)
+		if err != nil {
+			return fmt.Errorf("Error serializing the Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+
+		var policy storageManagementPolicySchema
+		if err := json.Unmarshal(policyJson, &policy); err != nil {
+			return fmt.Errorf("Error deserializing the Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+
+		rules = flattenStorageManagementPolicyRules(policy.Rules)
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return fmt.Errorf("Error flattening `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	storageAccountName := id.Path["storageAccounts"]
+
+	resp, err := client.Delete(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting the Management Policy for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) *[]storageManagementPolicyRule {
+	rules := make([]storageManagementPolicyRule, 0)
+
+	for _, r := range input {
+		rule := r.(map[string]interface{})
+
+		definition := storageManagementPolicyDefinition{
+			Filters: &storageManagementPolicyFilter{},
+			Actions: &storageManagementPolicyActionSet{},
+		}
+
+		if filters := rule["filters"].([]interface{}); len(filters) > 0 && filters[0] != nil {
+			filter := filters[0].(map[string]interface{})
+
+			prefixMatches := make([]string, 0)
+			for _, v := range filter["prefix_match"].(*schema.Set).List() {
+				prefixMatches = append(prefixMatches, v.(string))
+			}
+			definition.Filters.PrefixMatch = &prefixMatches
+
+			blobTypes := make([]string, 0)
+			for _, v := range filter["blob_types"].(*schema.Set).List() {
+				blobTypes = append(blobTypes, v.(string))
+			}
+			definition.Filters.BlobTypes = &blobTypes
+		}
+
+		if actions := rule["actions"].([]interface{}); len(actions) > 0 && actions[0] != nil {
+			action := actions[0].(map[string]interface{})
+
+			if v := action["base_blob"].([]interface{}); len(v) > 0 && v[0] != nil {
+				baseBlob := v[0].(map[string]interface{})
+				definition.Actions.BaseBlob = &storageManagementPolicyBaseBlob{
+					TierToCool:    expandStorageManagementPolicyDaysAfterModification(baseBlob["tier_to_cool_after_days_since_modification_greater_than"]),
+					TierToArchive: expandStorageManagementPolicyDaysAfterModification(baseBlob["tier_to_archive_after_days_since_modification_greater_than"]),
+					Delete:        expandStorageManagementPolicyDaysAfterModification(baseBlob["delete_after_days_since_modification_greater_than"]),
+				}
+			}
+
+			if v := action["snapshot"].([]interface{}); len(v) > 0 && v[0] != nil {
+				snapshot := v[0].(map[string]interface{})
+				days := snapshot["delete_after_days_since_creation_greater_than"].(int)
+				definition.Actions.Snapshot = &storageManagementPolicySnapShot{
+					Delete: &storageDateAfterCreation{
+						DaysAfterCreationGreaterThan: utils.Float(float64(days)),
+					},
+				}
+			}
+		}
+
+		rules = append(rules, storageManagementPolicyRule{
+			Name:       utils.String(rule["name"].(string)),
+			Type:       utils.String("Lifecycle"),
+			Definition: &definition,
+		})
+	}
+
+	return &rules
+}
+
+func expandStorageManagementPolicyDaysAfterModification(input interface{}) *storageDateAfterModification {
+	days, ok := input.(int)
+	if !ok || days == 0 {
+		return nil
+	}
+
+	return &storageDateAfterModification{
+		DaysAfterModificationGreaterThan: utils.Float(float64(days)),
+	}
+}
+
+func flattenStorageManagementPolicyRules(input *[]storageManagementPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		output := make(map[string]interface{})
+
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+
+		filters := make([]interface{}, 0)
+		actions := make([]interface{}, 0)
+		if definition := rule.Definition; definition != nil {
+			if filter := definition.Filters; filter != nil {
+				prefixMatches := &schema.Set{F: schema.HashString}
+				if filter.PrefixMatch != nil {
+					for _, v := range *filter.PrefixMatch {
+						prefixMatches.Add(v)
+					}
+				}
+
+				blobTypes := &schema.Set{F: schema.HashString}
+				if filter.BlobTypes != nil {
+					for _, v := range *filter.BlobTypes {
+						blobTypes.Add(v)
+					}
+				}
+
+				filters = append(filters, map[string]interface{}{
+					"prefix_match": prefixMatches,
+					"blob_types":   blobTypes,
+				})
+			}
+
+			if action := definition.Actions; action != nil {
+				baseBlobs := make([]interface{}, 0)
+				if baseBlob := action.BaseBlob; baseBlob != nil {
+					values := make(map[string]interface{})
+					if days := flattenStorageManagementPolicyDaysAfterModification(baseBlob.TierToCool); days != nil {
+						values["tier_to_cool_after_days_since_modification_greater_than"] = *days
+					}
+					if days := flattenStorageManagementPolicyDaysAfterModification(baseBlob.TierToArchive); days != nil {
+						values["tier_to_archive_after_days_since_modification_greater_than"] = *days
+					}
+					if days := flattenStorageManagementPolicyDaysAfterModification(baseBlob.Delete); days != nil {
+						values["delete_after_days_since_modification_greater_than"] = *days
+					}
+					baseBlobs = append(baseBlobs, values)
+				}
+
+				snapshots := make([]interface{}, 0)
+				if snapshot := action.Snapshot; snapshot != nil {
+					values := make(map[string]interface{})
+					if snapshot.Delete != nil && snapshot.Delete.DaysAfterCreationGreaterThan != nil {
+						values["delete_after_days_since_creation_greater_than"] = int(*snapshot.Delete.DaysAfterCreationGreaterThan)
+					}
+					snapshots = append(snapshots, values)
+				}
+
+				actions = append(actions, map[string]interface{}{
+					"base_blob": baseBlobs,
+					"snapshot":  snapshots,
+				})
+			}
+		}
+		output["filters"] = filters
+		output["actions"] = actions
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenStorageManagementPolicyDaysAfterModification(input *storageDateAfterModification) *int {
+	if input == nil || input.DaysAfterModificationGreaterThan == nil {
+		return nil
+	}
+
+	days := int(*input.DaysAfterModificationGreaterThan)
+	return &days
+}
+
+func validateStorageManagementPolicyRuleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can only contain alphanumeric characters: %q", k, value))
+	}
+
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q can be at most 256 characters: %q", k, value))
+	}
+
+	return
+}
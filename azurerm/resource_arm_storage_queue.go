@@ -15,6 +15,7 @@ func resourceArmStorageQueue() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageQueueCreate,
 		Read:   resourceArmStorageQueueRead,
+		Update: resourceArmStorageQueueUpdate,
 		Delete: resourceArmStorageQueueDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,7 @@ func resourceArmStorageQueue() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"acl": storageACLSchema("raup"),
 		},
 	}
 }
@@ -93,11 +95,63 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating storage queue on Azure: %s", err)
 	}
 
+	if v, ok := d.GetOk("acl"); ok {
+		accessPolicies, err := expandStorageQueueACL(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Setting the ACL for queue %q in storage account %q", name, storageAccountName)
+		permissions := storage.QueuePermissions{
+			AccessPolicies: accessPolicies,
+		}
+		if err := queueReference.SetPermissions(permissions, &storage.SetQueuePermissionOptions{}); err != nil {
+			return fmt.Errorf("Error setting the ACL for storage queue %q: %s", name, err)
+		}
+	}
+
 	id := fmt.Sprintf("https://%s.queue.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
 	d.SetId(id)
 	return resourceArmStorageQueueRead(d, meta)
 }
 
+func resourceArmStorageQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	queueClient, accountExists, err := armClient.getQueueServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("acl") {
+		accessPolicies, err := expandStorageQueueACL(d.Get("acl").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Updating the ACL for queue %q in storage account %q", id.queueName, id.storageAccountName)
+		permissions := storage.QueuePermissions{
+			AccessPolicies: accessPolicies,
+		}
+		queueReference := queueClient.GetQueueReference(id.queueName)
+		if err := queueReference.SetPermissions(permissions, &storage.SetQueuePermissionOptions{}); err != nil {
+			return fmt.Errorf("Error updating the ACL for storage queue %q: %s", id.queueName, err)
+		}
+	}
+
+	return resourceArmStorageQueueRead(d, meta)
+}
+
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -145,6 +199,15 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", *resourceGroup)
 
+	permissions, err := queueReference.GetPermissions(&storage.GetQueuePermissionOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ACL for storage queue %q: %s", id.queueName, err)
+	}
+
+	if err := d.Set("acl", flattenStorageQueueACL(permissions.AccessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `acl`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -186,6 +249,46 @@ func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func expandStorageQueueACL(input []interface{}) ([]storage.QueueAccessPolicy, error) {
+	policies, err := expandStorageACL(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]storage.QueueAccessPolicy, 0)
+	for _, v := range policies {
+		output = append(output, storage.QueueAccessPolicy{
+			ID:         v.ID,
+			StartTime:  v.Start,
+			ExpiryTime: v.Expiry,
+			CanRead:    strings.Contains(v.Permissions, "r"),
+			CanAdd:     strings.Contains(v.Permissions, "a"),
+			CanUpdate:  strings.Contains(v.Permissions, "u"),
+			CanProcess: strings.Contains(v.Permissions, "p"),
+		})
+	}
+	return output, nil
+}
+
+func flattenStorageQueueACL(input []storage.QueueAccessPolicy) []interface{} {
+	policies := make([]storageAccessPolicy, 0)
+	for _, v := range input {
+		permissions := map[string]bool{
+			"r": v.CanRead,
+			"a": v.CanAdd,
+			"u": v.CanUpdate,
+			"p": v.CanProcess,
+		}
+		policies = append(policies, storageAccessPolicy{
+			ID:          v.ID,
+			Start:       v.StartTime,
+			Expiry:      v.ExpiryTime,
+			Permissions: storagePermissionsString(permissions, "raup"),
+		})
+	}
+	return flattenStorageACL(policies)
+}
+
 type storageQueueId struct {
 	storageAccountName string
 	queueName          string
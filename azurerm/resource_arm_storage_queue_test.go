@@ -76,6 +76,42 @@ func TestAccAzureRMStorageQueue_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageQueue_acl(t *testing.T) {
+	resourceName := "azurerm_storage_queue.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageQueue_acl(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageQueueExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "acl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "acl.0.access_policy.0.permissions", "raup"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageQueue_aclUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageQueueExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "acl.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "acl.1.access_policy.0.permissions", "rp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageQueueExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -178,3 +214,87 @@ resource "azurerm_storage_queue" "test" {
 }
 `, rInt, location, rString, rInt)
 }
+
+func testAccAzureRMStorageQueue_acl(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name                     = "acctestacc%s"
+    resource_group_name      = "${azurerm_resource_group.test.name}"
+    location                 = "${azurerm_resource_group.test.location}"
+    account_tier             = "Standard"
+    account_replication_type = "LRS"
+
+    tags {
+        environment = "staging"
+    }
+}
+
+resource "azurerm_storage_queue" "test" {
+    name = "mysamplequeue-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+
+    acl {
+        id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+        access_policy {
+            start       = "2019-07-02T09:38:21Z"
+            expiry      = "2019-07-02T10:38:21Z"
+            permissions = "raup"
+        }
+    }
+}
+`, rInt, location, rString, rInt)
+}
+
+func testAccAzureRMStorageQueue_aclUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name                     = "acctestacc%s"
+    resource_group_name      = "${azurerm_resource_group.test.name}"
+    location                 = "${azurerm_resource_group.test.location}"
+    account_tier             = "Standard"
+    account_replication_type = "LRS"
+
+    tags {
+        environment = "staging"
+    }
+}
+
+resource "azurerm_storage_queue" "test" {
+    name = "mysamplequeue-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+
+    acl {
+        id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+        access_policy {
+            start       = "2019-07-02T09:38:21Z"
+            expiry      = "2019-07-02T10:38:21Z"
+            permissions = "raup"
+        }
+    }
+
+    acl {
+        id = "AAAANDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+        access_policy {
+            start       = "2019-07-02T09:38:21Z"
+            expiry      = "2019-07-03T09:38:21Z"
+            permissions = "rp"
+        }
+    }
+}
+`, rInt, location, rString, rInt)
+}
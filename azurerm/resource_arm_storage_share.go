@@ -47,6 +47,7 @@ func resourceArmStorageShare() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"acl": storageACLSchema("rcwdl"),
 		},
 	}
 }
@@ -83,6 +84,26 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	reference.SetProperties(options)
 
+	if v, ok := d.GetOk("acl"); ok {
+		accessPolicies, err := expandStorageACL(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		aclClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		log.Printf("[INFO] Setting share %q ACL in storage account %q", name, storageAccountName)
+		if err := aclClient.SetShareACL(ctx, name, accessPolicies); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s/%s", name, resourceGroupName, storageAccountName))
 	return resourceArmStorageShareRead(d, meta)
 }
@@ -133,6 +154,25 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 	reference.FetchAttributes(nil)
 	d.Set("quota", reference.Properties.Quota)
 
+	aclClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage account %q not found, removing file %q from state", storageAccountName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	accessPolicies, err := aclClient.GetShareACL(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("acl", flattenStorageACL(accessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `acl`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -166,6 +206,26 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 	reference.SetProperties(options)
 
+	if d.HasChange("acl") {
+		accessPolicies, err := expandStorageACL(d.Get("acl").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		aclClient, accountExists, err := armClient.getSharedKeyClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+		if !accountExists {
+			return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+		}
+
+		log.Printf("[INFO] Updating share %q ACL in storage account %q", name, storageAccountName)
+		if err := aclClient.SetShareACL(ctx, name, accessPolicies); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmStorageShareRead(d, meta)
 }
 
@@ -40,6 +40,44 @@ func TestAccAzureRMStorageShare_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageShare_acl(t *testing.T) {
+	resourceName := "azurerm_storage_share.test"
+	var sS storage.Share
+
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageShare_acl(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareExists(resourceName, &sS),
+					resource.TestCheckResourceAttr(resourceName, "acl.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "acl.0.access_policy.0.permissions", "rcwdl"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageShare_aclUpdated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageShareExists(resourceName, &sS),
+					resource.TestCheckResourceAttr(resourceName, "acl.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "acl.1.access_policy.0.permissions", "rl"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageShare_disappears(t *testing.T) {
 	var sS storage.Share
 
@@ -312,3 +350,87 @@ func TestValidateArmStorageShareName(t *testing.T) {
 		}
 	}
 }
+
+func testAccAzureRMStorageShare_acl(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name                     = "acctestacc%s"
+    resource_group_name      = "${azurerm_resource_group.test.name}"
+    location                 = "${azurerm_resource_group.test.location}"
+    account_tier             = "Standard"
+    account_replication_type = "LRS"
+
+    tags {
+        environment = "staging"
+    }
+}
+
+resource "azurerm_storage_share" "test" {
+    name = "testshare"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+
+    acl {
+        id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+        access_policy {
+            start       = "2019-07-02T09:38:21Z"
+            expiry      = "2019-07-02T10:38:21Z"
+            permissions = "rcwdl"
+        }
+    }
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageShare_aclUpdated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+    name                     = "acctestacc%s"
+    resource_group_name      = "${azurerm_resource_group.test.name}"
+    location                 = "${azurerm_resource_group.test.location}"
+    account_tier             = "Standard"
+    account_replication_type = "LRS"
+
+    tags {
+        environment = "staging"
+    }
+}
+
+resource "azurerm_storage_share" "test" {
+    name = "testshare"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+
+    acl {
+        id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+        access_policy {
+            start       = "2019-07-02T09:38:21Z"
+            expiry      = "2019-07-02T10:38:21Z"
+            permissions = "rcwdl"
+        }
+    }
+
+    acl {
+        id = "AAAANDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+        access_policy {
+            start       = "2019-07-02T09:38:21Z"
+            expiry      = "2019-07-03T09:38:21Z"
+            permissions = "rl"
+        }
+    }
+}
+`, rInt, location, rString)
+}
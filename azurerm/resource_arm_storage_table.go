@@ -15,6 +15,7 @@ func resourceArmStorageTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmStorageTableCreate,
 		Read:   resourceArmStorageTableRead,
+		Update: resourceArmStorageTableUpdate,
 		Delete: resourceArmStorageTableDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,7 @@ func resourceArmStorageTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"acl": storageACLSchema("raud"),
 		},
 	}
 }
@@ -83,11 +85,59 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating table %q in storage account %q: %s", name, storageAccountName, err)
 	}
 
+	if v, ok := d.GetOk("acl"); ok {
+		accessPolicies, err := expandStorageTableACL(v.([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Setting the ACL for table %q in storage account %q.", name, storageAccountName)
+		if err := table.SetPermissions(accessPolicies, timeout, options); err != nil {
+			return fmt.Errorf("Error setting the ACL for table %q in storage account %q: %s", name, storageAccountName, err)
+		}
+	}
+
 	id := fmt.Sprintf("https://%s.table.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
 	d.SetId(id)
 	return resourceArmStorageTableRead(d, meta)
 }
 
+func resourceArmStorageTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	id, err := parseStorageTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	tableClient, accountExists, err := armClient.getTableServiceClientForStorageAccount(ctx, resourceGroupName, id.storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", id.storageAccountName)
+	}
+
+	if d.HasChange("acl") {
+		accessPolicies, err := expandStorageTableACL(d.Get("acl").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Updating the ACL for table %q in storage account %q.", id.tableName, id.storageAccountName)
+		table := tableClient.GetTableReference(id.tableName)
+		timeout := uint(60)
+		options := &storage.TableOptions{}
+		if err := table.SetPermissions(accessPolicies, timeout, options); err != nil {
+			return fmt.Errorf("Error updating the ACL for table %q in storage account %q: %s", id.tableName, id.storageAccountName, err)
+		}
+	}
+
+	return resourceArmStorageTableRead(d, meta)
+}
+
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	ctx := armClient.StopContext
@@ -144,6 +194,15 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("storage_account_name", id.storageAccountName)
 	d.Set("resource_group_name", resourceGroup)
 
+	accessPolicies, err := tableClient.GetTableReference(id.tableName).GetPermissions(60, &storage.TableOptions{})
+	if err != nil {
+		return fmt.Errorf("Error retrieving the ACL for table %q in storage account %q: %s", id.tableName, id.storageAccountName, err)
+	}
+
+	if err := d.Set("acl", flattenStorageTableACL(accessPolicies)); err != nil {
+		return fmt.Errorf("Error flattening `acl`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -187,6 +246,46 @@ func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func expandStorageTableACL(input []interface{}) ([]storage.TableAccessPolicy, error) {
+	policies, err := expandStorageACL(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]storage.TableAccessPolicy, 0)
+	for _, v := range policies {
+		output = append(output, storage.TableAccessPolicy{
+			ID:         v.ID,
+			StartTime:  v.Start,
+			ExpiryTime: v.Expiry,
+			CanRead:    strings.Contains(v.Permissions, "r"),
+			CanAppend:  strings.Contains(v.Permissions, "a"),
+			CanUpdate:  strings.Contains(v.Permissions, "u"),
+			CanDelete:  strings.Contains(v.Permissions, "d"),
+		})
+	}
+	return output, nil
+}
+
+func flattenStorageTableACL(input []storage.TableAccessPolicy) []interface{} {
+	policies := make([]storageAccessPolicy, 0)
+	for _, v := range input {
+		permissions := map[string]bool{
+			"r": v.CanRead,
+			"a": v.CanAppend,
+			"u": v.CanUpdate,
+			"d": v.CanDelete,
+		}
+		policies = append(policies, storageAccessPolicy{
+			ID:          v.ID,
+			Start:       v.StartTime,
+			Expiry:      v.ExpiryTime,
+			Permissions: storagePermissionsString(permissions, "raud"),
+		})
+	}
+	return flattenStorageACL(policies)
+}
+
 type storageTableId struct {
 	storageAccountName string
 	tableName          string
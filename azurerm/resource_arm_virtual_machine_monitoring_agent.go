@@ -0,0 +1,431 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/preview/operationalinsights/mgmt/2015-11-01-preview/operationalinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type virtualMachineMonitoringAgentExtension struct {
+	Name               string
+	Publisher          string
+	TypeHandlerVersion string
+}
+
+// the Monitoring Agent (which reports to Log Analytics) and the Dependency Agent (which is used by Service Map
+// and Azure Monitor for VMs) are different extensions depending on the Operating System of the Virtual Machine
+var virtualMachineMonitoringAgentExtensions = map[compute.OperatingSystemTypes]virtualMachineMonitoringAgentExtension{
+	compute.Linux: {
+		Name:               "OmsAgentForLinux",
+		Publisher:          "Microsoft.EnterpriseCloud.Monitoring",
+		TypeHandlerVersion: "1.7",
+	},
+	compute.Windows: {
+		Name:               "MicrosoftMonitoringAgent",
+		Publisher:          "Microsoft.EnterpriseCloud.Monitoring",
+		TypeHandlerVersion: "1.0",
+	},
+}
+
+var virtualMachineDependencyAgentExtensions = map[compute.OperatingSystemTypes]virtualMachineMonitoringAgentExtension{
+	compute.Linux: {
+		Name:               "DependencyAgentLinux",
+		Publisher:          "Microsoft.Azure.Monitoring.DependencyAgent",
+		TypeHandlerVersion: "9.5",
+	},
+	compute.Windows: {
+		Name:               "DependencyAgentWindows",
+		Publisher:          "Microsoft.Azure.Monitoring.DependencyAgent",
+		TypeHandlerVersion: "9.5",
+	},
+}
+
+func resourceArmVirtualMachineMonitoringAgent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineMonitoringAgentCreateUpdate,
+		Read:   resourceArmVirtualMachineMonitoringAgentRead,
+		Update: resourceArmVirtualMachineMonitoringAgentCreateUpdate,
+		Delete: resourceArmVirtualMachineMonitoringAgentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     azure.ValidateResourceID,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     azure.ValidateResourceID,
+			},
+
+			"dependency_agent_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"log_analytics_workspace_customer_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineMonitoringAgentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	vmClient := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Virtual Machine Monitoring Agent creation.")
+
+	virtualMachineId := d.Get("virtual_machine_id").(string)
+	workspaceId := d.Get("log_analytics_workspace_id").(string)
+	dependencyAgentEnabled := d.Get("dependency_agent_enabled").(bool)
+	autoUpgradeMinorVersion := d.Get("auto_upgrade_minor_version").(bool)
+
+	vmId, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resGroup := vmId.ResourceGroup
+	vmName := vmId.Path["virtualMachines"]
+
+	vm, err := vmClient.Get(ctx, resGroup, vmName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+	if vm.Location == nil {
+		return fmt.Errorf("Cannot read the Location of Virtual Machine %q (Resource Group %q)", vmName, resGroup)
+	}
+	location := *vm.Location
+
+	osType := virtualMachineMonitoringAgentOSType(vm)
+	agent, supported := virtualMachineMonitoringAgentExtensions[osType]
+	if !supported {
+		return fmt.Errorf("Unable to determine the Operating System of Virtual Machine %q (Resource Group %q) - got %q", vmName, resGroup, string(osType))
+	}
+
+	// the Workspace ID and Key are looked up here rather than being specified, which keeps the Key out of the configuration
+	workspace, err := parseAzureResourceID(workspaceId)
+	if err != nil {
+		return err
+	}
+	workspaceResGroup := workspace.ResourceGroup
+	workspaceName := workspace.Path["workspaces"]
+	workspacesClient := virtualMachineMonitoringAgentWorkspacesClient(meta, workspace.SubscriptionID)
+
+	read, err := workspacesClient.Get(ctx, workspaceResGroup, workspaceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Workspace %q (Resource Group %q): %+v", workspaceName, workspaceResGroup, err)
+	}
+	if read.WorkspaceProperties == nil || read.WorkspaceProperties.CustomerID == nil {
+		return fmt.Errorf("Cannot read the Customer ID of Log Analytics Workspace %q (Resource Group %q)", workspaceName, workspaceResGroup)
+	}
+	customerId := *read.WorkspaceProperties.CustomerID
+
+	sharedKeys, err := workspacesClient.GetSharedKeys(ctx, workspaceResGroup, workspaceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Shared Keys for Log Analytics Workspace %q (Resource Group %q): %+v", workspaceName, workspaceResGroup, err)
+	}
+	if sharedKeys.PrimarySharedKey == nil {
+		return fmt.Errorf("Cannot read the Primary Shared Key of Log Analytics Workspace %q (Resource Group %q)", workspaceName, workspaceResGroup)
+	}
+
+	agentExtension := expandVirtualMachineMonitoringAgentExtension(location, agent, autoUpgradeMinorVersion)
+	agentExtension.VirtualMachineExtensionProperties.Settings = map[string]interface{}{
+		"workspaceId": customerId,
+	}
+	agentExtension.VirtualMachineExtensionProperties.ProtectedSettings = map[string]interface{}{
+		"workspaceKey": *sharedKeys.PrimarySharedKey,
+	}
+
+	if err := createVirtualMachineMonitoringAgentExtension(meta, resGroup, vmName, agent.Name, agentExtension); err != nil {
+		return err
+	}
+
+	// the Dependency Agent requires the Monitoring Agent, so it's provisioned afterwards
+	dependencyAgent := virtualMachineDependencyAgentExtensions[osType]
+	if dependencyAgentEnabled {
+		dependencyExtension := expandVirtualMachineMonitoringAgentExtension(location, dependencyAgent, autoUpgradeMinorVersion)
+		if err := createVirtualMachineMonitoringAgentExtension(meta, resGroup, vmName, dependencyAgent.Name, dependencyExtension); err != nil {
+			return err
+		}
+	} else if !d.IsNewResource() && d.HasChange("dependency_agent_enabled") {
+		if err := deleteVirtualMachineMonitoringAgentExtension(meta, resGroup, vmName, dependencyAgent.Name); err != nil {
+			return err
+		}
+	}
+
+	if d.IsNewResource() {
+		client := meta.(*ArmClient).vmExtensionClient
+		resp, err := client.Get(ctx, resGroup, vmName, agent.Name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", agent.Name, vmName, resGroup, err)
+		}
+		if resp.ID == nil {
+			return fmt.Errorf("Cannot read Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q) ID", agent.Name, vmName, resGroup)
+		}
+
+		// the Monitoring Agent is the primary extension, so this resource takes its ID
+		d.SetId(*resp.ID)
+	}
+
+	return resourceArmVirtualMachineMonitoringAgentRead(d, meta)
+}
+
+func resourceArmVirtualMachineMonitoringAgentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	osType, err := virtualMachineMonitoringAgentOSTypeFromExtensionName(name)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, resGroup, vmName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Machine Extension %q was not found in Virtual Machine %q (Resource Group %q) - removing from state", name, vmName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resGroup, err)
+	}
+
+	// the Virtual Machine ID is the ID of this resource, without the `/extensions/{name}` suffix
+	virtualMachineId := d.Id()[:strings.LastIndex(strings.ToLower(d.Id()), "/extensions/")]
+	d.Set("virtual_machine_id", virtualMachineId)
+	d.Set("os_type", string(osType))
+
+	if props := resp.VirtualMachineExtensionProperties; props != nil {
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+
+		customerId := ""
+		if settings, ok := props.Settings.(map[string]interface{}); ok {
+			if v, ok := settings["workspaceId"].(string); ok {
+				customerId = v
+			}
+		}
+		d.Set("log_analytics_workspace_customer_id", customerId)
+
+		// the extension only references the Customer ID of the Workspace - so the configured Workspace is kept unless
+		// it's known to have a different Customer ID, and is only resolved from the Customer ID when importing
+		if workspaceId := d.Get("log_analytics_workspace_id").(string); workspaceId != "" {
+			matches, err := virtualMachineMonitoringAgentWorkspaceHasCustomerId(meta, workspaceId, customerId)
+			if err != nil {
+				log.Printf("[WARN] Unable to confirm the Customer ID of Log Analytics Workspace %q - assuming it's unchanged: %+v", workspaceId, err)
+			} else if !matches {
+				d.Set("log_analytics_workspace_id", "")
+			}
+		} else {
+			workspaceId, err := findVirtualMachineMonitoringAgentWorkspaceId(meta, customerId)
+			if err != nil {
+				return err
+			}
+			d.Set("log_analytics_workspace_id", workspaceId)
+		}
+	}
+
+	dependencyAgent := virtualMachineDependencyAgentExtensions[osType]
+	dependencyResp, err := client.Get(ctx, resGroup, vmName, dependencyAgent.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(dependencyResp.Response) {
+			return fmt.Errorf("Error retrieving Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", dependencyAgent.Name, vmName, resGroup, err)
+		}
+	}
+	d.Set("dependency_agent_enabled", err == nil)
+
+	return nil
+}
+
+func resourceArmVirtualMachineMonitoringAgentDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+	name := id.Path["extensions"]
+
+	osType, err := virtualMachineMonitoringAgentOSTypeFromExtensionName(name)
+	if err != nil {
+		return err
+	}
+
+	// the Dependency Agent requires the Monitoring Agent, so it needs to be removed first
+	dependencyAgent := virtualMachineDependencyAgentExtensions[osType]
+	if err := deleteVirtualMachineMonitoringAgentExtension(meta, resGroup, vmName, dependencyAgent.Name); err != nil {
+		return err
+	}
+
+	return deleteVirtualMachineMonitoringAgentExtension(meta, resGroup, vmName, name)
+}
+
+// virtualMachineMonitoringAgentWorkspacesClient returns a Workspaces client for the specified Subscription, since the
+// Workspace can be in a different Subscription to the Virtual Machine (e.g. a central Workspace)
+func virtualMachineMonitoringAgentWorkspacesClient(meta interface{}, subscriptionId string) operationalinsights.WorkspacesClient {
+	client := meta.(*ArmClient).workspacesClient
+	if subscriptionId != "" {
+		client.SubscriptionID = subscriptionId
+	}
+	return client
+}
+
+func virtualMachineMonitoringAgentWorkspaceHasCustomerId(meta interface{}, workspaceId string, customerId string) (bool, error) {
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(workspaceId)
+	if err != nil {
+		return false, err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["workspaces"]
+
+	client := virtualMachineMonitoringAgentWorkspacesClient(meta, id.SubscriptionID)
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error retrieving Log Analytics Workspace %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if resp.WorkspaceProperties == nil || resp.WorkspaceProperties.CustomerID == nil {
+		return false, fmt.Errorf("Cannot read the Customer ID of Log Analytics Workspace %q (Resource Group %q)", name, resGroup)
+	}
+
+	return strings.EqualFold(*resp.WorkspaceProperties.CustomerID, customerId), nil
+}
+
+// findVirtualMachineMonitoringAgentWorkspaceId resolves the Workspace with the specified Customer ID within this Subscription
+func findVirtualMachineMonitoringAgentWorkspaceId(meta interface{}, customerId string) (string, error) {
+	client := meta.(*ArmClient).workspacesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	if customerId == "" {
+		return "", nil
+	}
+
+	workspaces, err := client.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Error listing Log Analytics Workspaces: %+v", err)
+	}
+
+	if workspaces.Value != nil {
+		for _, workspace := range *workspaces.Value {
+			if workspace.ID == nil || workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.CustomerID == nil {
+				continue
+			}
+
+			if strings.EqualFold(*workspace.WorkspaceProperties.CustomerID, customerId) {
+				return *workspace.ID, nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] No Log Analytics Workspace with the Customer ID %q was found in this Subscription", customerId)
+	return "", nil
+}
+
+func expandVirtualMachineMonitoringAgentExtension(location string, agent virtualMachineMonitoringAgentExtension, autoUpgradeMinorVersion bool) compute.VirtualMachineExtension {
+	return compute.VirtualMachineExtension{
+		Location: utils.String(location),
+		VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+			Publisher:               utils.String(agent.Publisher),
+			Type:                    utils.String(agent.Name),
+			TypeHandlerVersion:      utils.String(agent.TypeHandlerVersion),
+			AutoUpgradeMinorVersion: utils.Bool(autoUpgradeMinorVersion),
+		},
+	}
+}
+
+func createVirtualMachineMonitoringAgentExtension(meta interface{}, resGroup string, vmName string, name string, extension compute.VirtualMachineExtension) error {
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, vmName, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error creating Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resGroup, err)
+	}
+
+	return nil
+}
+
+func deleteVirtualMachineMonitoringAgentExtension(meta interface{}, resGroup string, vmName string, name string) error {
+	client := meta.(*ArmClient).vmExtensionClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.Delete(ctx, resGroup, vmName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func virtualMachineMonitoringAgentOSType(vm compute.VirtualMachine) compute.OperatingSystemTypes {
+	if props := vm.VirtualMachineProperties; props != nil {
+		if profile := props.StorageProfile; profile != nil && profile.OsDisk != nil {
+			return profile.OsDisk.OsType
+		}
+	}
+
+	return ""
+}
+
+func virtualMachineMonitoringAgentOSTypeFromExtensionName(name string) (compute.OperatingSystemTypes, error) {
+	for osType, agent := range virtualMachineMonitoringAgentExtensions {
+		if strings.EqualFold(agent.Name, name) {
+			return osType, nil
+		}
+	}
+
+	return "", fmt.Errorf("Expected the Virtual Machine Extension to be a Monitoring Agent (`MicrosoftMonitoringAgent` or `OmsAgentForLinux`) - got %q", name)
+}
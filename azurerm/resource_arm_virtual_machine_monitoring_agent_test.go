@@ -0,0 +1,293 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineMonitoringAgent_linux(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_monitoring_agent.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineMonitoringAgent_linux(ri, testLocation(), true)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineMonitoringAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "OmsAgentForLinux"),
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "DependencyAgentLinux"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+					resource.TestCheckResourceAttr(resourceName, "dependency_agent_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_analytics_workspace_customer_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineMonitoringAgent_linuxUpdate(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_monitoring_agent.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineMonitoringAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineMonitoringAgent_linux(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "OmsAgentForLinux"),
+					resource.TestCheckResourceAttr(resourceName, "dependency_agent_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineMonitoringAgent_linux(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "DependencyAgentLinux"),
+					resource.TestCheckResourceAttr(resourceName, "dependency_agent_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineMonitoringAgent_linux(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "OmsAgentForLinux"),
+					resource.TestCheckResourceAttr(resourceName, "dependency_agent_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineMonitoringAgent_windows(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_monitoring_agent.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineMonitoringAgent_windows(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineMonitoringAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "MicrosoftMonitoringAgent"),
+					testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName, "DependencyAgentWindows"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Windows"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineMonitoringAgentExists(resourceName string, extensionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vmName := id.Path["virtualMachines"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, vmName, extensionName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q) does not exist", extensionName, vmName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on vmExtensionClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineMonitoringAgentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_monitoring_agent" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		vmName := id.Path["virtualMachines"]
+		name := id.Path["extensions"]
+
+		resp, err := client.Get(ctx, resourceGroup, vmName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q) still exists", name, vmName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineMonitoringAgent_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMVirtualMachineMonitoringAgent_linux(rInt int, location string, dependencyAgentEnabled bool) string {
+	template := testAccAzureRMVirtualMachineMonitoringAgent_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine" "test" {
+  name                          = "acctvm-%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  network_interface_ids         = ["${azurerm_network_interface.test.id}"]
+  vm_size                       = "Standard_F2"
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hostname%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+
+resource "azurerm_virtual_machine_monitoring_agent" "test" {
+  virtual_machine_id         = "${azurerm_virtual_machine.test.id}"
+  log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+  dependency_agent_enabled   = %t
+}
+`, template, rInt, rInt, rInt, dependencyAgentEnabled)
+}
+
+func testAccAzureRMVirtualMachineMonitoringAgent_windows(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineMonitoringAgent_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine" "test" {
+  name                          = "acctvm-%d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  network_interface_ids         = ["${azurerm_network_interface.test.id}"]
+  vm_size                       = "Standard_F2"
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "acctvm%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_windows_config {}
+}
+
+resource "azurerm_virtual_machine_monitoring_agent" "test" {
+  virtual_machine_id         = "${azurerm_virtual_machine.test.id}"
+  log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+}
+`, template, rInt, rInt, rInt%1000000)
+}
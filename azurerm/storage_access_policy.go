@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// storageAccessPolicy is a Stored Access Policy (also known as a Signed Identifier) which is
+// common to Containers, Shares, Queues & Tables, from which a Service SAS can be generated
+type storageAccessPolicy struct {
+	ID          string
+	Start       time.Time
+	Expiry      time.Time
+	Permissions string
+}
+
+// storageAccessPolicyTimeFormat is the format the API returns these times in (rounded to the second)
+const storageAccessPolicyTimeFormat = "2006-01-02T15:04:05Z"
+
+// storageACLSchema returns the schema for the Stored Access Policies on a Storage Container, Share, Queue or Table.
+// `validPermissions` is the ordered set of permissions supported by that type of resource (e.g. `rwd`)
+func storageACLSchema(validPermissions string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		// a maximum of 5 Stored Access Policies can be set on a resource at any one time
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+
+				"access_policy": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"start": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateFunc:     validate.RFC3339Time,
+								DiffSuppressFunc: suppressStorageAccessPolicyTimeDiff,
+							},
+
+							"expiry": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateFunc:     validate.RFC3339Time,
+								DiffSuppressFunc: suppressStorageAccessPolicyTimeDiff,
+							},
+
+							"permissions": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateStoragePermissions(validPermissions),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// suppressStorageAccessPolicyTimeDiff suppresses the diff when the same point in time is specified using a different offset
+func suppressStorageAccessPolicyTimeDiff(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+// validateStoragePermissions ensures the permissions string only contains the permissions in `validPermissions`,
+// in the same order - since this is the order the API returns them in
+func validateStoragePermissions(validPermissions string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (_ []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if v == "" {
+			errors = append(errors, fmt.Errorf("%q must contain at least one permission", k))
+			return
+		}
+
+		remaining := validPermissions
+		for _, c := range v {
+			index := strings.IndexRune(remaining, c)
+			if index == -1 {
+				errors = append(errors, fmt.Errorf("%q must only contain the permissions %q (in that order, without duplicates) but got %q", k, validPermissions, v))
+				return
+			}
+			remaining = remaining[index+1:]
+		}
+
+		return
+	}
+}
+
+func expandStorageACL(input []interface{}) ([]storageAccessPolicy, error) {
+	policies := make([]storageAccessPolicy, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+		id := vals["id"].(string)
+
+		accessPolicies := vals["access_policy"].([]interface{})
+		if len(accessPolicies) == 0 || accessPolicies[0] == nil {
+			continue
+		}
+		accessPolicy := accessPolicies[0].(map[string]interface{})
+
+		start, err := time.Parse(time.RFC3339, accessPolicy["start"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `start` for Access Policy %q: %+v", id, err)
+		}
+
+		expiry, err := time.Parse(time.RFC3339, accessPolicy["expiry"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `expiry` for Access Policy %q: %+v", id, err)
+		}
+
+		policies = append(policies, storageAccessPolicy{
+			ID:          id,
+			Start:       start,
+			Expiry:      expiry,
+			Permissions: accessPolicy["permissions"].(string),
+		})
+	}
+
+	return policies, nil
+}
+
+func flattenStorageACL(input []storageAccessPolicy) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, v := range input {
+		results = append(results, map[string]interface{}{
+			"id": v.ID,
+			"access_policy": []interface{}{
+				map[string]interface{}{
+					"start":       v.Start.UTC().Format(storageAccessPolicyTimeFormat),
+					"expiry":      v.Expiry.UTC().Format(storageAccessPolicyTimeFormat),
+					"permissions": v.Permissions,
+				},
+			},
+		})
+	}
+
+	return results
+}
+
+// storagePermissionsString builds the permissions string (e.g. `rwd`) from the permissions which are granted
+func storagePermissionsString(permissions map[string]bool, validPermissions string) string {
+	output := ""
+	for _, c := range validPermissions {
+		if permissions[string(c)] {
+			output += string(c)
+		}
+	}
+	return output
+}
@@ -0,0 +1,30 @@
+package azurerm
+
+import "testing"
+
+func TestValidateStoragePermissions(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"r", false},
+		{"rwd", false},
+		{"rd", false},
+		{"dr", true},
+		{"rr", true},
+		{"rwl", true},
+	}
+
+	for _, test := range testCases {
+		_, es := validateStoragePermissions("rwd")(test.input, "permissions")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating permissions %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating permissions %q to succeed", test.input)
+		}
+	}
+}
@@ -0,0 +1,449 @@
+package azurerm
+
+import (
+	"strings"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// storageServicePropertiesSchema returns the schema for the Service Properties (CORS, Logging & Metrics)
+// of the Blob, Queue & Table services within a Storage Account
+func storageServicePropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cors_rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_origins": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 64,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.NoZeroValues,
+								},
+							},
+
+							"allowed_methods": {
+								Type:     schema.TypeList,
+								Required: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										"DELETE",
+										"GET",
+										"HEAD",
+										"MERGE",
+										"POST",
+										"OPTIONS",
+										"PUT",
+									}, false),
+								},
+							},
+
+							"allowed_headers": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 64,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+
+							"exposed_headers": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 64,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+
+							"max_age_in_seconds": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 2000000000),
+							},
+						},
+					},
+				},
+
+				"logging": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"version": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+
+							"delete": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+
+							"read": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+
+							"write": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+
+							"retention_policy_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(1, 365),
+							},
+						},
+					},
+				},
+
+				"hour_metrics":   storageServicePropertiesMetricsSchema(),
+				"minute_metrics": storageServicePropertiesMetricsSchema(),
+			},
+		},
+	}
+}
+
+// storageBlobServicePropertiesSchema returns the schema for the Service Properties of the Blob service,
+// which additionally supports Soft Delete, Static Website hosting and a Default Service Version
+func storageBlobServicePropertiesSchema() *schema.Schema {
+	s := storageServicePropertiesSchema()
+	fields := s.Elem.(*schema.Resource).Schema
+
+	fields["default_service_version"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.NoZeroValues,
+	}
+
+	fields["delete_retention_policy"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"days": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+
+	fields["static_website"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"index_document": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"error_404_document": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+
+	return s
+}
+
+func storageServicePropertiesMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func expandStorageServiceProperties(input []interface{}) mainStorage.ServiceProperties {
+	// when a block is removed we explicitly disable the functionality, since omitting
+	// an element from the request leaves the existing configuration in place
+	properties := mainStorage.ServiceProperties{
+		Cors: &mainStorage.Cors{
+			CorsRule: []mainStorage.CorsRule{},
+		},
+		Logging: &mainStorage.Logging{
+			Version: "1.0",
+			RetentionPolicy: &mainStorage.RetentionPolicy{
+				Enabled: false,
+			},
+		},
+		HourMetrics:   expandStorageServicePropertiesMetrics([]interface{}{}),
+		MinuteMetrics: expandStorageServicePropertiesMetrics([]interface{}{}),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+
+	v := input[0].(map[string]interface{})
+
+	for _, r := range v["cors_rule"].([]interface{}) {
+		rule := r.(map[string]interface{})
+		properties.Cors.CorsRule = append(properties.Cors.CorsRule, mainStorage.CorsRule{
+			AllowedOrigins:  expandStorageServicePropertiesCorsList(rule["allowed_origins"].([]interface{})),
+			AllowedMethods:  expandStorageServicePropertiesCorsList(rule["allowed_methods"].([]interface{})),
+			AllowedHeaders:  expandStorageServicePropertiesCorsList(rule["allowed_headers"].([]interface{})),
+			ExposedHeaders:  expandStorageServicePropertiesCorsList(rule["exposed_headers"].([]interface{})),
+			MaxAgeInSeconds: rule["max_age_in_seconds"].(int),
+		})
+	}
+
+	if logging := v["logging"].([]interface{}); len(logging) > 0 && logging[0] != nil {
+		l := logging[0].(map[string]interface{})
+		properties.Logging = &mainStorage.Logging{
+			Version:         l["version"].(string),
+			Delete:          l["delete"].(bool),
+			Read:            l["read"].(bool),
+			Write:           l["write"].(bool),
+			RetentionPolicy: expandStorageServicePropertiesRetentionPolicy(l["retention_policy_days"].(int)),
+		}
+	}
+
+	properties.HourMetrics = expandStorageServicePropertiesMetrics(v["hour_metrics"].([]interface{}))
+	properties.MinuteMetrics = expandStorageServicePropertiesMetrics(v["minute_metrics"].([]interface{}))
+
+	return properties
+}
+
+func expandStorageBlobServiceProperties(input []interface{}) storageBlobServiceProperties {
+	// as above, omitted blocks are explicitly disabled
+	properties := storageBlobServiceProperties{
+		DeleteRetentionPolicy: &storageDeleteRetentionPolicy{
+			Enabled: false,
+		},
+		StaticWebsite: &storageStaticWebsite{
+			Enabled: false,
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+
+	v := input[0].(map[string]interface{})
+
+	properties.DefaultServiceVersion = v["default_service_version"].(string)
+
+	if policies := v["delete_retention_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		days := policy["days"].(int)
+		properties.DeleteRetentionPolicy = &storageDeleteRetentionPolicy{
+			Enabled: true,
+			Days:    &days,
+		}
+	}
+
+	if websites := v["static_website"].([]interface{}); len(websites) > 0 {
+		properties.StaticWebsite = &storageStaticWebsite{
+			Enabled: true,
+		}
+		if websites[0] != nil {
+			website := websites[0].(map[string]interface{})
+			properties.StaticWebsite.IndexDocument = website["index_document"].(string)
+			properties.StaticWebsite.ErrorDocument404Path = website["error_404_document"].(string)
+		}
+	}
+
+	return properties
+}
+
+func expandStorageServicePropertiesMetrics(input []interface{}) *mainStorage.Metrics {
+	if len(input) == 0 || input[0] == nil {
+		return &mainStorage.Metrics{
+			Version: "1.0",
+			Enabled: false,
+			RetentionPolicy: &mainStorage.RetentionPolicy{
+				Enabled: false,
+			},
+		}
+	}
+
+	// the API only accepts `IncludeAPIs` when metrics are enabled
+	v := input[0].(map[string]interface{})
+	includeAPIs := v["include_apis"].(bool)
+	return &mainStorage.Metrics{
+		Version:         v["version"].(string),
+		Enabled:         true,
+		IncludeAPIs:     &includeAPIs,
+		RetentionPolicy: expandStorageServicePropertiesRetentionPolicy(v["retention_policy_days"].(int)),
+	}
+}
+
+func expandStorageServicePropertiesRetentionPolicy(days int) *mainStorage.RetentionPolicy {
+	if days == 0 {
+		return &mainStorage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &mainStorage.RetentionPolicy{
+		Enabled: true,
+		Days:    &days,
+	}
+}
+
+func expandStorageServicePropertiesCorsList(input []interface{}) string {
+	values := make([]string, 0)
+	for _, v := range input {
+		values = append(values, v.(string))
+	}
+	return strings.Join(values, ",")
+}
+
+func flattenStorageServiceProperties(input *mainStorage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	corsRules := make([]interface{}, 0)
+	if cors := input.Cors; cors != nil {
+		for _, rule := range cors.CorsRule {
+			corsRules = append(corsRules, map[string]interface{}{
+				"allowed_origins":    flattenStorageServicePropertiesCorsList(rule.AllowedOrigins),
+				"allowed_methods":    flattenStorageServicePropertiesCorsList(rule.AllowedMethods),
+				"allowed_headers":    flattenStorageServicePropertiesCorsList(rule.AllowedHeaders),
+				"exposed_headers":    flattenStorageServicePropertiesCorsList(rule.ExposedHeaders),
+				"max_age_in_seconds": rule.MaxAgeInSeconds,
+			})
+		}
+	}
+
+	logging := make([]interface{}, 0)
+	if l := input.Logging; l != nil && (l.Delete || l.Read || l.Write) {
+		logging = append(logging, map[string]interface{}{
+			"version":               l.Version,
+			"delete":                l.Delete,
+			"read":                  l.Read,
+			"write":                 l.Write,
+			"retention_policy_days": flattenStorageServicePropertiesRetentionPolicy(l.RetentionPolicy),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":      corsRules,
+			"logging":        logging,
+			"hour_metrics":   flattenStorageServicePropertiesMetrics(input.HourMetrics),
+			"minute_metrics": flattenStorageServicePropertiesMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
+// flattenStorageBlobServiceProperties merges the Blob-specific Service Properties into the flattened Service Properties
+func flattenStorageBlobServiceProperties(serviceProperties []interface{}, input *storageBlobServiceProperties) []interface{} {
+	if len(serviceProperties) == 0 || input == nil {
+		return serviceProperties
+	}
+
+	output := serviceProperties[0].(map[string]interface{})
+	output["default_service_version"] = input.DefaultServiceVersion
+
+	deleteRetentionPolicies := make([]interface{}, 0)
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled {
+		days := 0
+		if policy.Days != nil {
+			days = *policy.Days
+		}
+		deleteRetentionPolicies = append(deleteRetentionPolicies, map[string]interface{}{
+			"days": days,
+		})
+	}
+	output["delete_retention_policy"] = deleteRetentionPolicies
+
+	staticWebsites := make([]interface{}, 0)
+	if website := input.StaticWebsite; website != nil && website.Enabled {
+		staticWebsites = append(staticWebsites, map[string]interface{}{
+			"index_document":     website.IndexDocument,
+			"error_404_document": website.ErrorDocument404Path,
+		})
+	}
+	output["static_website"] = staticWebsites
+
+	return []interface{}{output}
+}
+
+func flattenStorageServicePropertiesMetrics(input *mainStorage.Metrics) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": flattenStorageServicePropertiesRetentionPolicy(input.RetentionPolicy),
+		},
+	}
+}
+
+func flattenStorageServicePropertiesRetentionPolicy(input *mainStorage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
+
+func flattenStorageServicePropertiesCorsList(input string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == "" {
+		return results
+	}
+
+	for _, v := range strings.Split(input, ",") {
+		results = append(results, strings.TrimSpace(v))
+	}
+	return results
+}
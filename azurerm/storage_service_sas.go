@@ -0,0 +1,228 @@
+package azurerm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// These are SERVICE SAS's : https://docs.microsoft.com/en-us/rest/api/storageservices/constructing-a-service-sas
+// which are scoped to a single Container, Blob, Share or Queue - and can optionally reference a Stored Access Policy
+
+// storageServiceSasHeaders are the response headers which can be overridden when using a Blob or File Service SAS
+var storageServiceSasHeaders = map[string]string{
+	"cache_control":       "rscc",
+	"content_disposition": "rscd",
+	"content_encoding":    "rsce",
+	"content_language":    "rscl",
+	"content_type":        "rsct",
+}
+
+// storageServiceSasSchema returns the fields common to the Service SAS Data Sources, where `permissions`
+// are the names of the permissions available for this kind of resource and `overrideHeaders` determines
+// if the response headers can be overridden (which is only possible for the Blob & File services)
+func storageServiceSasSchema(permissions []string, overrideHeaders bool) map[string]*schema.Schema {
+	permissionsSchema := make(map[string]*schema.Schema)
+	for _, permission := range permissions {
+		permissionsSchema[permission] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Required: true,
+		}
+	}
+
+	s := map[string]*schema.Schema{
+		"connection_string": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+
+		"https_only": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"ip_address": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"start": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"expiry": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"access_policy_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"permissions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: permissionsSchema,
+			},
+		},
+
+		"sas": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+
+	if overrideHeaders {
+		for name := range storageServiceSasHeaders {
+			s[name] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}
+		}
+	}
+
+	return s
+}
+
+type storageServiceSasOptions struct {
+	// service is the name of the Storage Service in the Canonicalized Resource (e.g. `blob`)
+	service string
+	// resourcePath is the path to the resource within the Storage Account (e.g. `container/blob`)
+	resourcePath string
+	// signedResource is the type of resource (e.g. `c` for a Container) - this isn't used for Queues
+	signedResource string
+	// permissionNames maps the field within the `permissions` block to the permission character (e.g. `read` -> `r`)
+	permissionNames map[string]string
+	// permissionsOrder is the order in which permissions must be specified in the SAS
+	permissionsOrder string
+}
+
+// dataSourceArmStorageServiceSasRead computes a Service SAS from the fields defined in `storageServiceSasSchema`
+func dataSourceArmStorageServiceSasRead(d *schema.ResourceData, options storageServiceSasOptions) error {
+	kvp, err := parseAzureStorageAccountConnectionString(d.Get("connection_string").(string))
+	if err != nil {
+		return err
+	}
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+
+	permissions := ""
+	if v := d.Get("permissions").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		granted := make(map[string]bool)
+		for name, character := range options.permissionNames {
+			granted[character] = raw[name].(bool)
+		}
+		permissions = storagePermissionsString(granted, options.permissionsOrder)
+	}
+
+	start := d.Get("start").(string)
+	expiry := d.Get("expiry").(string)
+	accessPolicyId := d.Get("access_policy_id").(string)
+
+	// when a Stored Access Policy isn't referenced, the permissions & expiry must be specified in the SAS itself
+	if accessPolicyId == "" {
+		if expiry == "" {
+			return fmt.Errorf("`expiry` must be specified when `access_policy_id` isn't set")
+		}
+		if permissions == "" {
+			return fmt.Errorf("at least one permission must be granted in the `permissions` block when `access_policy_id` isn't set")
+		}
+	}
+
+	signedProtocol := "https,http"
+	if d.Get("https_only").(bool) {
+		signedProtocol = "https"
+	}
+
+	headers := make(map[string]string)
+	if options.signedResource != "" {
+		for name, key := range storageServiceSasHeaders {
+			headers[key] = d.Get(name).(string)
+		}
+	}
+
+	canonicalizedResource := fmt.Sprintf("/%s/%s/%s", options.service, accountName, options.resourcePath)
+	sasToken, err := computeAzureStorageServiceSas(accountKey, canonicalizedResource, options.signedResource, permissions,
+		start, expiry, accessPolicyId, d.Get("ip_address").(string), signedProtocol, sasSignedVersion, headers)
+	if err != nil {
+		return err
+	}
+
+	d.Set("sas", sasToken)
+	tokenHash := sha256.Sum256([]byte(sasToken))
+	d.SetId(hex.EncodeToString(tokenHash[:]))
+
+	return nil
+}
+
+func computeAzureStorageServiceSas(accountKey string,
+	canonicalizedResource string,
+	signedResource string,
+	permissions string,
+	start string,
+	expiry string,
+	signedIdentifier string,
+	signedIp string,
+	signedProtocol string,
+	signedVersion string,
+	headers map[string]string) (string, error) {
+
+	stringToSign := []string{
+		permissions,
+		start,
+		expiry,
+		canonicalizedResource,
+		signedIdentifier,
+		signedIp,
+		signedProtocol,
+		signedVersion,
+	}
+
+	// the Queue service doesn't have a Signed Resource, nor does it support overriding the response headers
+	if signedResource != "" {
+		stringToSign = append(stringToSign, headers["rscc"], headers["rscd"], headers["rsce"], headers["rscl"], headers["rsct"])
+	}
+
+	binaryKey, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return "", err
+	}
+	hasher := hmac.New(sha256.New, binaryKey)
+	hasher.Write([]byte(strings.Join(stringToSign, "\n")))
+	signature := hasher.Sum(nil)
+
+	values := url.Values{}
+	addValue := func(key, value string) {
+		if value != "" {
+			values.Set(key, value)
+		}
+	}
+	addValue("sv", signedVersion)
+	addValue("sr", signedResource)
+	addValue("sp", permissions)
+	addValue("st", start)
+	addValue("se", expiry)
+	addValue("si", signedIdentifier)
+	addValue("sip", signedIp)
+	addValue("spr", signedProtocol)
+	for key, value := range headers {
+		addValue(key, value)
+	}
+	values.Set("sig", base64.StdEncoding.EncodeToString(signature))
+
+	return "?" + values.Encode(), nil
+}
@@ -0,0 +1,391 @@
+package azurerm
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: the vendored version of the Storage SDK doesn't support getting/setting the ACL for a File Share (nor the
+// List permission within a Container's ACL), getting/setting the Access Tier of a Blob, nor the Soft Delete, Static
+// Website & Default Service Version Blob Service Properties (which require a newer API version) - as such this is a
+// minimal client for those API's which should be replaced by the SDK's client once that's been upgraded.
+
+// storageBlobAccessTierAPIVersion is the API version used to get/set the Access Tier of a Blob
+const storageBlobAccessTierAPIVersion = "2018-03-28"
+
+// storageSharedKeyClientTimeout is the maximum duration of a single request to the Storage Data Plane API
+const storageSharedKeyClientTimeout = 5 * time.Minute
+
+// storageBlobServicePropertiesAPIVersion is the API version used to get/set the Blob Service Properties
+const storageBlobServicePropertiesAPIVersion = "2018-03-28"
+
+// storageBlobServiceProperties contains the Blob Service Properties which aren't supported by the vendored SDK -
+// elements which are omitted from a request are left unchanged by the service.
+type storageBlobServiceProperties struct {
+	XMLName               xml.Name                      `xml:"StorageServiceProperties"`
+	DefaultServiceVersion string                        `xml:"DefaultServiceVersion,omitempty"`
+	DeleteRetentionPolicy *storageDeleteRetentionPolicy `xml:"DeleteRetentionPolicy,omitempty"`
+	StaticWebsite         *storageStaticWebsite         `xml:"StaticWebsite,omitempty"`
+}
+
+type storageDeleteRetentionPolicy struct {
+	Enabled bool `xml:"Enabled"`
+	Days    *int `xml:"Days,omitempty"`
+}
+
+type storageStaticWebsite struct {
+	Enabled              bool   `xml:"Enabled"`
+	IndexDocument        string `xml:"IndexDocument,omitempty"`
+	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+}
+
+type storageSharedKeyClient struct {
+	accountName    string
+	accountKey     string
+	endpointSuffix string
+	client         autorest.Client
+}
+
+func (armClient *ArmClient) getSharedKeyClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageSharedKeyClient, bool, error) {
+	key, accountExists, err := armClient.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, accountExists, err
+	}
+	if !accountExists {
+		return nil, false, nil
+	}
+
+	// requests are sent using the same User Agent, logging & retries as the Resource Manager clients
+	autorestClient := autorest.NewClientWithUserAgent("")
+	setUserAgent(&autorestClient)
+	autorestClient.Sender = autorest.DecorateSender(&http.Client{Timeout: storageSharedKeyClientTimeout}, withRequestLogging())
+
+	client := storageSharedKeyClient{
+		accountName:    storageAccountName,
+		accountKey:     key,
+		endpointSuffix: armClient.environment.StorageEndpointSuffix,
+		client:         autorestClient,
+	}
+	return &client, true, nil
+}
+
+// GetShareACL returns the Stored Access Policies defined on the specified Share.
+func (client storageSharedKeyClient) GetShareACL(ctx context.Context, shareName string) ([]storageAccessPolicy, error) {
+	policies, err := client.getACL(ctx, "file", shareName, storageACLQuery("share"))
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the ACL for Share %q: %+v", shareName, err)
+	}
+
+	return policies, nil
+}
+
+// SetShareACL replaces the Stored Access Policies defined on the specified Share.
+func (client storageSharedKeyClient) SetShareACL(ctx context.Context, shareName string, policies []storageAccessPolicy) error {
+	if err := client.setACL(ctx, "file", shareName, storageACLQuery("share"), nil, policies); err != nil {
+		return fmt.Errorf("Error setting the ACL for Share %q: %+v", shareName, err)
+	}
+
+	return nil
+}
+
+// GetContainerACL returns the Stored Access Policies defined on the specified Container.
+func (client storageSharedKeyClient) GetContainerACL(ctx context.Context, containerName string) ([]storageAccessPolicy, error) {
+	policies, err := client.getACL(ctx, "blob", containerName, storageACLQuery("container"))
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the ACL for Container %q: %+v", containerName, err)
+	}
+
+	return policies, nil
+}
+
+// SetContainerACL replaces the Stored Access Policies defined on the specified Container - since the
+// Public Access Level is set alongside the Stored Access Policies, it must also be specified.
+func (client storageSharedKeyClient) SetContainerACL(ctx context.Context, containerName string, accessType mainStorage.ContainerAccessType, policies []storageAccessPolicy) error {
+	headers := map[string]string{}
+	if accessType != mainStorage.ContainerAccessTypePrivate {
+		headers["x-ms-blob-public-access"] = string(accessType)
+	}
+
+	if err := client.setACL(ctx, "blob", containerName, storageACLQuery("container"), headers, policies); err != nil {
+		return fmt.Errorf("Error setting the ACL for Container %q: %+v", containerName, err)
+	}
+
+	return nil
+}
+
+func (client storageSharedKeyClient) getACL(ctx context.Context, service, path string, query url.Values) ([]storageAccessPolicy, error) {
+	resp, err := client.send(ctx, http.MethodGet, service, path, query, nil, mainStorage.DefaultAPIVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading response: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var identifiers mainStorage.SignedIdentifiers
+	if len(body) > 0 {
+		if err := xml.Unmarshal(body, &identifiers); err != nil {
+			return nil, fmt.Errorf("Error parsing response: %+v", err)
+		}
+	}
+
+	policies := make([]storageAccessPolicy, 0)
+	for _, v := range identifiers.SignedIdentifiers {
+		policies = append(policies, storageAccessPolicy{
+			ID:          v.ID,
+			Start:       v.AccessPolicy.StartTime,
+			Expiry:      v.AccessPolicy.ExpiryTime,
+			Permissions: v.AccessPolicy.Permission,
+		})
+	}
+
+	return policies, nil
+}
+
+func (client storageSharedKeyClient) setACL(ctx context.Context, service, path string, query url.Values, headers map[string]string, policies []storageAccessPolicy) error {
+	identifiers := mainStorage.SignedIdentifiers{
+		SignedIdentifiers: []mainStorage.SignedIdentifier{},
+	}
+	for _, v := range policies {
+		identifiers.SignedIdentifiers = append(identifiers.SignedIdentifiers, mainStorage.SignedIdentifier{
+			ID: v.ID,
+			AccessPolicy: mainStorage.AccessPolicyDetailsXML{
+				StartTime:  v.Start.UTC().Round(time.Second),
+				ExpiryTime: v.Expiry.UTC().Round(time.Second),
+				Permission: v.Permissions,
+			},
+		})
+	}
+
+	body, err := xml.Marshal(identifiers)
+	if err != nil {
+		return fmt.Errorf("Error serializing request: %+v", err)
+	}
+
+	resp, err := client.send(ctx, http.MethodPut, service, path, query, headers, mainStorage.DefaultAPIVersion, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+func storageACLQuery(resourceType string) url.Values {
+	return url.Values{
+		"comp":    []string{"acl"},
+		"restype": []string{resourceType},
+	}
+}
+
+// GetBlobAccessTier returns the Access Tier of the specified Blob - which is empty when the
+// Storage Account doesn't support Access Tiers at the Blob level.
+func (client storageSharedKeyClient) GetBlobAccessTier(ctx context.Context, containerName, blobName string) (string, error) {
+	path := fmt.Sprintf("%s/%s", containerName, blobName)
+	resp, err := client.send(ctx, http.MethodHead, "blob", path, url.Values{}, nil, storageBlobAccessTierAPIVersion, nil)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Access Tier for Blob %q (Container %q): %+v", blobName, containerName, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error retrieving the Access Tier for Blob %q (Container %q): unexpected status %d", blobName, containerName, resp.StatusCode)
+	}
+
+	return resp.Header.Get("x-ms-access-tier"), nil
+}
+
+// SetBlobAccessTier sets the Access Tier of the specified Blob.
+func (client storageSharedKeyClient) SetBlobAccessTier(ctx context.Context, containerName, blobName, tier string) error {
+	path := fmt.Sprintf("%s/%s", containerName, blobName)
+	query := url.Values{
+		"comp": []string{"tier"},
+	}
+	headers := map[string]string{
+		"x-ms-access-tier": tier,
+	}
+	resp, err := client.send(ctx, http.MethodPut, "blob", path, query, headers, storageBlobAccessTierAPIVersion, nil)
+	if err != nil {
+		return fmt.Errorf("Error setting the Access Tier for Blob %q (Container %q): %+v", blobName, containerName, err)
+	}
+	defer resp.Body.Close()
+
+	// the API returns a 202 when rehydrating a Blob from the Archive tier
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Error setting the Access Tier for Blob %q (Container %q): unexpected status %d: %s", blobName, containerName, resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// GetBlobServiceProperties returns the Soft Delete, Static Website & Default Service Version properties of the Blob Service.
+func (client storageSharedKeyClient) GetBlobServiceProperties(ctx context.Context) (*storageBlobServiceProperties, error) {
+	resp, err := client.send(ctx, http.MethodGet, "blob", "", storageServicePropertiesQuery(), nil, storageBlobServicePropertiesAPIVersion, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the Blob Service Properties: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the Blob Service Properties: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error retrieving the Blob Service Properties: unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var properties storageBlobServiceProperties
+	if err := xml.Unmarshal(body, &properties); err != nil {
+		return nil, fmt.Errorf("Error parsing the Blob Service Properties: %+v", err)
+	}
+
+	return &properties, nil
+}
+
+// SetBlobServiceProperties updates the Soft Delete, Static Website & Default Service Version properties of the Blob Service.
+func (client storageSharedKeyClient) SetBlobServiceProperties(ctx context.Context, properties storageBlobServiceProperties) error {
+	body, err := xml.Marshal(properties)
+	if err != nil {
+		return fmt.Errorf("Error serializing the Blob Service Properties: %+v", err)
+	}
+
+	resp, err := client.send(ctx, http.MethodPut, "blob", "", storageServicePropertiesQuery(), nil, storageBlobServicePropertiesAPIVersion, body)
+	if err != nil {
+		return fmt.Errorf("Error setting the Blob Service Properties: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Error setting the Blob Service Properties: unexpected status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+func storageServicePropertiesQuery() url.Values {
+	return url.Values{
+		"comp":    []string{"properties"},
+		"restype": []string{"service"},
+	}
+}
+
+func (client storageSharedKeyClient) send(ctx context.Context, method, service, path string, query url.Values, headers map[string]string, apiVersion string, body []byte) (*http.Response, error) {
+	escapedPath := (&url.URL{Path: "/" + path}).EscapedPath()
+	uri := fmt.Sprintf("https://%s.%s.%s%s", client.accountName, service, client.endpointSuffix, escapedPath)
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("Error building request: %+v", err)
+	}
+	req = req.WithContext(ctx)
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", apiVersion)
+
+	contentLength := ""
+	if len(body) > 0 {
+		contentLength = strconv.Itoa(len(body))
+	}
+
+	authorization, err := client.sharedKeySignature(method, contentLength, req.Header, escapedPath, query)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", authorization)
+
+	return autorest.SendWithSender(client.client, req,
+		autorest.DoRetryForStatusCodes(client.client.RetryAttempts, client.client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// sharedKeySignature computes the Shared Key authorization header for a request to the Storage Service
+// https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (client storageSharedKeyClient) sharedKeySignature(method, contentLength string, headers http.Header, escapedPath string, query url.Values) (string, error) {
+	headerNames := make([]string, 0)
+	for name := range headers {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			headerNames = append(headerNames, lower)
+		}
+	}
+	sort.Strings(headerNames)
+
+	canonicalizedHeaders := ""
+	for _, name := range headerNames {
+		canonicalizedHeaders += fmt.Sprintf("%s:%s\n", name, strings.TrimSpace(headers.Get(name)))
+	}
+
+	queryNames := make([]string, 0)
+	for name := range query {
+		queryNames = append(queryNames, name)
+	}
+	sort.Strings(queryNames)
+
+	canonicalizedResource := fmt.Sprintf("/%s%s", client.accountName, escapedPath)
+	for _, name := range queryNames {
+		values := query[name]
+		sort.Strings(values)
+		canonicalizedResource += fmt.Sprintf("\n%s:%s", strings.ToLower(name), strings.Join(values, ","))
+	}
+
+	stringToSign := strings.Join([]string{
+		method,
+		"", // Content-Encoding
+		"", // Content-Language
+		contentLength,
+		"", // Content-MD5
+		"", // Content-Type
+		"", // Date
+		"", // If-Modified-Since
+		"", // If-Match
+		"", // If-None-Match
+		"", // If-Unmodified-Since
+		"", // Range
+		canonicalizedHeaders + canonicalizedResource,
+	}, "\n")
+
+	key, err := base64.StdEncoding.DecodeString(client.accountKey)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the key for Storage Account %q: %+v", client.accountName, err)
+	}
+
+	hasher := hmac.New(sha256.New, key)
+	hasher.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+
+	return fmt.Sprintf("SharedKey %s:%s", client.accountName, signature), nil
+}
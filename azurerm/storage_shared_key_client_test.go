@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestStorageSharedKeyClientSignature(t *testing.T) {
+	testCases := []struct {
+		name          string
+		method        string
+		contentLength string
+		headers       map[string]string
+		path          string
+		query         url.Values
+		expected      string
+	}{
+		{
+			name:   "Get Container ACL",
+			method: "GET",
+			headers: map[string]string{
+				"x-ms-date":    "Mon, 01 Jan 2018 00:00:00 GMT",
+				"x-ms-version": "2018-03-28",
+			},
+			path:     "container1",
+			query:    storageACLQuery("container"),
+			expected: "SharedKey account1:WRWPJyIkc0U/vYSXpwDowGZfER5zYkLppbm+Tqx87Yk=",
+		},
+		{
+			name:          "Set Blob Access Tier",
+			method:        "PUT",
+			contentLength: "12",
+			headers: map[string]string{
+				"x-ms-access-tier": "Cool",
+				"X-Ms-Date":        "Mon, 01 Jan 2018 00:00:00 GMT",
+				"x-ms-version":     "2018-03-28",
+			},
+			path: "container1/blob name.txt",
+			query: url.Values{
+				"comp": []string{"tier"},
+			},
+			expected: "SharedKey account1:bx2WzavottGkXrKySofWA+DLvzvrqCYh0XgFnZxPijs=",
+		},
+	}
+
+	client := storageSharedKeyClient{
+		accountName: "account1",
+		accountKey:  "dGVzdGtleQ==",
+	}
+
+	for _, test := range testCases {
+		headers := http.Header{}
+		for k, v := range test.headers {
+			headers.Set(k, v)
+		}
+		escapedPath := (&url.URL{Path: "/" + test.path}).EscapedPath()
+
+		actual, err := client.sharedKeySignature(test.method, test.contentLength, headers, escapedPath, test.query)
+		if err != nil {
+			t.Fatalf("Expected %q to be signed but got an error: %+v", test.name, err)
+		}
+
+		if actual != test.expected {
+			t.Fatalf("Expected %q to be signed as %q but got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestStorageSharedKeyClientSignature_invalidKey(t *testing.T) {
+	client := storageSharedKeyClient{
+		accountName: "account1",
+		accountKey:  "not-a-base64-key",
+	}
+
+	if _, err := client.sharedKeySignature("GET", "", http.Header{}, "/container1", url.Values{}); err == nil {
+		t.Fatalf("Expected signing with an invalid key to return an error")
+	}
+}
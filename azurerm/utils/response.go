@@ -6,6 +6,10 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 )
 
+func ResponseWasBadRequest(resp autorest.Response) bool {
+	return responseWasStatusCode(resp, http.StatusBadRequest)
+}
+
 func ResponseWasConflict(resp autorest.Response) bool {
 	return responseWasStatusCode(resp, http.StatusConflict)
 }